@@ -0,0 +1,36 @@
+package directed
+
+import (
+	"context"
+	"github.com/pradykaushik/data-structures/graphs"
+)
+
+// The context variants below are the ones in graphs.Traversal, which describes how they stop once
+// the context is cancelled.
+
+// DfsContext is the cancellable variant of Dfs.
+func (g DirectedGraph) DfsContext(ctx context.Context) ([]int, error) {
+	return graphs.NewTraversal(&g).Dfs(ctx)
+}
+
+// BfsContext is the cancellable variant of Bfs.
+func (g DirectedGraph) BfsContext(ctx context.Context) ([]int, error) {
+	return graphs.NewTraversal(&g).Bfs(ctx)
+}
+
+// ConnectedVerticesContext is the cancellable variant of ConnectedVertices.
+// Returns error if the source does not exist.
+func (g DirectedGraph) ConnectedVerticesContext(ctx context.Context, source int) ([]int, error) {
+	return graphs.NewTraversal(&g).ConnectedVertices(ctx, source)
+}
+
+// FindPathContext is the cancellable variant of FindPath.
+// Returns error if either of the vertices does not exist.
+func (g DirectedGraph) FindPathContext(ctx context.Context, source, dest int) ([]int, bool, error) {
+	return graphs.NewTraversal(&g).FindPath(ctx, source, dest)
+}
+
+// FindConnectedComponentsContext is the cancellable variant of FindConnectedComponents.
+func (g DirectedGraph) FindConnectedComponentsContext(ctx context.Context) ([][]int, error) {
+	return g.weakTraversal().ConnectedComponents(ctx)
+}
@@ -0,0 +1,129 @@
+package directed
+
+import (
+	"context"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// countdownContext is a context that is cancelled after Err() has been called n times.
+// It is used to cancel a walk at a known point.
+type countdownContext struct {
+	context.Context
+	n int
+}
+
+func (ctx *countdownContext) Err() error {
+	if ctx.n <= 0 {
+		return context.Canceled
+	}
+	ctx.n--
+	return nil
+}
+
+func TestDirectedGraph_LongPath(t *testing.T) {
+	const n = 200000
+	dg := NewDirectedGraph(n).(*DirectedGraph)
+	for v := 0; v+1 < n; v++ {
+		dg.AddEdge(v, v+1)
+	}
+
+	assert.Len(t, dg.Dfs(), n)
+	connected, validVertex := dg.ConnectedVertices(0)
+	assert.True(t, validVertex)
+	assert.Len(t, connected, n)
+	path, found := dg.FindPath(0, n-1)
+	assert.True(t, found)
+	assert.Len(t, path, n)
+	pathV2, found := dg.FindPathV2(0, n-1)
+	assert.True(t, found)
+	assert.Equal(t, path, pathV2)
+	assert.Len(t, dg.FindConnectedComponents(), 1)
+	assert.False(t, dg.HasCycle())
+	order, err := dg.TopologicalSort()
+	assert.NoError(t, err)
+	assert.Equal(t, path, order)
+	assert.Equal(t, n, dg.KosarajuSCC().Count())
+	assert.Equal(t, n, dg.TarjanSCC().Count())
+
+	// Closing the path into a single cycle.
+	dg.AddEdge(n-1, 0)
+	assert.Len(t, dg.Cycle(), n+1)
+	assert.Equal(t, 1, dg.KosarajuSCC().Count())
+	assert.Equal(t, 1, dg.TarjanSCC().Count())
+}
+
+func TestDirectedGraph_CancellableGraph(t *testing.T) {
+	var dg graphs.Graph = getDirectedGraph(t)
+	_, ok := dg.(graphs.CancellableGraph)
+	assert.True(t, ok)
+}
+
+func TestDirectedGraph_TraversalsContext(t *testing.T) {
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	dfsOrder, err := dg.DfsContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, dg.Dfs(), dfsOrder)
+	bfsOrder, err := dg.BfsContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, dg.Bfs(), bfsOrder)
+	components, err := dg.FindConnectedComponentsContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, dg.FindConnectedComponents(), components)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	dfsOrder, err = dg.DfsContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Empty(t, dfsOrder)
+	bfsOrder, err = dg.BfsContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Empty(t, bfsOrder)
+	components, err = dg.FindConnectedComponentsContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Empty(t, components)
+
+	dfsOrder, err = dg.DfsContext(&countdownContext{Context: context.Background(), n: 6})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, dg.Dfs()[:6], dfsOrder)
+	bfsOrder, err = dg.BfsContext(&countdownContext{Context: context.Background(), n: 6})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, dg.Bfs()[:6], bfsOrder)
+}
+
+func TestDirectedGraph_ConnectedVerticesContext(t *testing.T) {
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	for v := 0; v < 13; v++ {
+		expected, _ := dg.ConnectedVertices(v)
+		result, err := dg.ConnectedVerticesContext(context.Background(), v)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	}
+
+	result, err := dg.ConnectedVerticesContext(&countdownContext{Context: context.Background(), n: 3}, 0)
+	assert.Equal(t, context.Canceled, err)
+	assert.Len(t, result, 3)
+
+	_, err = dg.ConnectedVerticesContext(context.Background(), 13)
+	assert.Error(t, err)
+}
+
+func TestDirectedGraph_FindPathContext(t *testing.T) {
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	for _, p := range [][]int{{0, 3}, {7, 12}, {1, 0}, {6, 6}} {
+		expected, expectedFound := dg.FindPath(p[0], p[1])
+		path, found, err := dg.FindPathContext(context.Background(), p[0], p[1])
+		assert.NoError(t, err)
+		assert.Equal(t, expectedFound, found)
+		assert.Equal(t, expected, path)
+	}
+
+	path, found, err := dg.FindPathContext(&countdownContext{Context: context.Background(), n: 1}, 7, 12)
+	assert.Equal(t, context.Canceled, err)
+	assert.False(t, found)
+	assert.Empty(t, path)
+
+	_, _, err = dg.FindPathContext(context.Background(), 13, 0)
+	assert.Error(t, err)
+}
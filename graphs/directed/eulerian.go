@@ -0,0 +1,124 @@
+package directed
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+)
+
+// EulerianCircuit returns a sequence of edges that uses every edge exactly once and ends
+// at the vertex it started from.
+// Returns error explaining why if no such circuit exists.
+//
+// A circuit exists if and only if every vertex has equal in-degree and out-degree and all the
+// edges are connected. A graph without edges has an empty circuit.
+func (g DirectedGraph) EulerianCircuit() ([]graphs.Pair, error) {
+	for v := range g.out {
+		indeg, _ := g.InDegree(v)
+		outdeg, _ := g.OutDegree(v)
+		if indeg != outdeg {
+			return []graphs.Pair{}, errors.Errorf(
+				"no eulerian circuit: vertex %d has in-degree %d and out-degree %d", v, indeg, outdeg)
+		}
+	}
+	return g.eulerian(-1)
+}
+
+// EulerianPath returns a sequence of edges that uses every edge exactly once.
+// Returns error explaining why if no such path exists.
+//
+// A path exists if and only if all the edges are connected and either every vertex has equal
+// in-degree and out-degree, in which case the path is a circuit, or exactly one vertex has one more
+// outgoing edge than incoming ones and exactly one vertex has one more incoming edge than outgoing
+// ones. The path then starts at the former and ends at the latter.
+func (g DirectedGraph) EulerianPath() ([]graphs.Pair, error) {
+	var source, sink = -1, -1
+	for v := range g.out {
+		indeg, _ := g.InDegree(v)
+		outdeg, _ := g.OutDegree(v)
+		switch {
+		case outdeg == indeg:
+			continue
+		case (outdeg == indeg+1) && (source == -1):
+			source = v
+		case (indeg == outdeg+1) && (sink == -1):
+			sink = v
+		default:
+			return []graphs.Pair{}, errors.Errorf(
+				"no eulerian path: vertex %d has in-degree %d and out-degree %d", v, indeg, outdeg)
+		}
+	}
+	if (source == -1) != (sink == -1) {
+		// The sum of the in-degrees equals the sum of the out-degrees, so this cannot happen.
+		return []graphs.Pair{}, errors.New("no eulerian path: unbalanced degrees")
+	}
+	return g.eulerian(source)
+}
+
+// eulerian finds the eulerian path starting at source using Hierholzer's algorithm, assuming
+// that the degrees of the vertices permit one. If source is -1, then any vertex with an edge is used.
+//
+// Starting from the source, unused edges are followed until a vertex with no unused edges is
+// reached, which can only be the end of the path. Vertices are then backtracked, and every vertex
+// with unused edges starts a detour that is spliced into the path at that vertex.
+// The walk follows edges rather than vertices, and comes back to a vertex once for every edge into
+// it, which is why it is not built on graphs.Walker. A stack of vertices keeps long paths from
+// resulting in deep recursion.
+func (g DirectedGraph) eulerian(source int) ([]graphs.Pair, error) {
+	var adjLists = make([][]int, len(g.out))
+	for v := range g.out {
+		adjLists[v], _ = g.Adjacent(v)
+	}
+	if g.numEdges == 0 {
+		return []graphs.Pair{}, nil
+	}
+
+	if source == -1 {
+		for v := range adjLists {
+			if len(adjLists[v]) > 0 {
+				source = v
+				break
+			}
+		}
+	}
+	// As the degrees are balanced, all the edges being weakly connected ensures that
+	// they are all reachable from the source.
+	for _, component := range g.FindConnectedComponents() {
+		var hasEdges = false
+		var hasSource = false
+		for _, v := range component {
+			deg, _ := g.Degree(v)
+			hasEdges = hasEdges || (deg > 0)
+			hasSource = hasSource || (v == source)
+		}
+		if hasEdges && !hasSource {
+			return []graphs.Pair{}, errors.Errorf(
+				"no eulerian path: edges at vertex %d are not connected to those at vertex %d",
+				component[0], source)
+		}
+	}
+
+	var next = make([]int, len(g.out)) // index of the next edge to follow in adjLists.
+	var vertexStack = []int{source}
+	var path = make([]graphs.Pair, 0, g.numEdges)
+	for len(vertexStack) > 0 {
+		v := vertexStack[len(vertexStack)-1]
+		if next[v] < len(adjLists[v]) {
+			w := adjLists[v][next[v]]
+			next[v]++
+			vertexStack = append(vertexStack, w)
+			continue
+		}
+		// No unused edges at v. The edge from the previous vertex to v is next on the path,
+		// when traversed from the end.
+		vertexStack = vertexStack[:len(vertexStack)-1]
+		if len(vertexStack) > 0 {
+			path = append(path, graphs.NewPair(vertexStack[len(vertexStack)-1], v))
+		}
+	}
+
+	// The path was built from the end.
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, nil
+}
@@ -0,0 +1,81 @@
+package directed
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func getGraphFromPairs(v int, pairs [][]int) *DirectedGraph {
+	dg := NewDirectedGraph(v).(*DirectedGraph)
+	for _, p := range pairs {
+		dg.AddEdge(p[0], p[1])
+	}
+	return dg
+}
+
+// testEulerianPath checks that the path is a walk that uses every edge exactly once.
+func testEulerianPath(t *testing.T, pairs [][]int, path []graphs.Pair) {
+	assert.Len(t, path, len(pairs))
+	var remaining = make(map[[2]int]int)
+	for _, p := range pairs {
+		remaining[[2]int{p[0], p[1]}]++
+	}
+	for i, e := range path {
+		if i > 0 {
+			assert.Equal(t, path[i-1].To(), e.From())
+		}
+		remaining[[2]int{e.From(), e.To()}]--
+	}
+	for _, count := range remaining {
+		assert.Zero(t, count)
+	}
+}
+
+func TestDirectedGraph_EulerianCircuit(t *testing.T) {
+	var pairs = [][]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 2}, {3, 3}, {0, 5}, {5, 0}}
+	circuit, err := getGraphFromPairs(6, pairs).EulerianCircuit()
+	assert.NoError(t, err)
+	testEulerianPath(t, pairs, circuit)
+	assert.Equal(t, circuit[0].From(), circuit[len(circuit)-1].To())
+
+	// Unbalanced degrees.
+	_, err = getGraphFromPairs(3, [][]int{{0, 1}, {1, 2}, {0, 2}}).EulerianCircuit()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "in-degree")
+
+	// Disconnected edges.
+	_, err = getGraphFromPairs(4, [][]int{{0, 1}, {1, 0}, {2, 3}, {3, 2}}).EulerianCircuit()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not connected")
+
+	circuit, err = NewDirectedGraph(2).(*DirectedGraph).EulerianCircuit()
+	assert.NoError(t, err)
+	assert.Empty(t, circuit)
+}
+
+func TestDirectedGraph_EulerianPath(t *testing.T) {
+	// 1 has one more outgoing edge and 3 has one more incoming edge.
+	var pairs = [][]int{{0, 1}, {1, 2}, {2, 0}, {1, 3}, {3, 4}, {4, 1}, {1, 3}}
+	path, err := getGraphFromPairs(5, pairs).EulerianPath()
+	assert.NoError(t, err)
+	testEulerianPath(t, pairs, path)
+	assert.Equal(t, 1, path[0].From())
+	assert.Equal(t, 3, path[len(path)-1].To())
+
+	// A circuit is also a path.
+	pairs = [][]int{{0, 1}, {1, 2}, {2, 0}}
+	path, err = getGraphFromPairs(3, pairs).EulerianPath()
+	assert.NoError(t, err)
+	testEulerianPath(t, pairs, path)
+
+	// Two vertices with more outgoing edges.
+	_, err = getGraphFromPairs(4, [][]int{{0, 1}, {2, 3}}).EulerianPath()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "in-degree")
+
+	// The path 0->1 is balanced by 2->3->2 in degrees but is disconnected from it.
+	_, err = getGraphFromPairs(4, [][]int{{0, 1}, {2, 3}, {3, 2}}).EulerianPath()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not connected")
+}
@@ -0,0 +1,259 @@
+package directed
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/linkedlist"
+	"github.com/pradykaushik/data-structures/util"
+)
+
+// DirectedGraph is a Graph where the edges are directed.
+// This means that an edge v->w can only be used to traverse from v to w.
+//
+// Both the outgoing and the incoming adjacency lists are stored so that
+// the in-degree of a vertex can be determined without scanning the entire graph.
+type DirectedGraph struct {
+	out         []*linkedlist.LinkedList // vertices pointed to by each vertex.
+	in          []*linkedlist.LinkedList // vertices pointing to each vertex.
+	numVertices int
+	numEdges    int
+}
+
+// Vertex implements util.Value and represents a vertex in the graph.
+type Vertex int
+
+func (v Vertex) Get() interface{} {
+	return int(v)
+}
+
+// NewDirectedGraph creates a directed graph with the provided number of vertices.
+// Note that this directed graph will have no edges to begin with.
+func NewDirectedGraph(v int) graphs.Digraph {
+	g := &DirectedGraph{
+		out:         make([]*linkedlist.LinkedList, v),
+		in:          make([]*linkedlist.LinkedList, v),
+		numVertices: v,
+		numEdges:    0,
+	}
+
+	for i := 0; i < g.numVertices; i++ {
+		g.out[i] = linkedlist.New()
+		g.in[i] = linkedlist.New()
+	}
+
+	return g
+}
+
+func (g DirectedGraph) GetV() int {
+	return g.numVertices
+}
+
+func (g DirectedGraph) GetE() int {
+	return g.numEdges
+}
+
+// isValid returns whether the given vertex exists in the graph.
+func (g DirectedGraph) isValid(v int) bool {
+	return (v >= 0) && (v < len(g.out))
+}
+
+// AddEdge adds the edge v1->v2.
+func (g *DirectedGraph) AddEdge(v1 int, v2 int) bool {
+	if !g.isValid(v1) || !g.isValid(v2) {
+		return false
+	}
+
+	g.out[v1].AddToFront(Vertex(v2))
+	g.in[v2].AddToFront(Vertex(v1))
+	g.numEdges++
+	return true
+}
+
+// HasEdge returns whether there is an edge v1->v2.
+func (g DirectedGraph) HasEdge(v1 int, v2 int) bool {
+	if !g.isValid(v1) || !g.isValid(v2) {
+		return false
+	}
+	return g.out[v1].Search(Vertex(v2))
+}
+
+// RemoveEdge removes an edge v1->v2.
+func (g *DirectedGraph) RemoveEdge(v1 int, v2 int) bool {
+	if !g.HasEdge(v1, v2) {
+		return false
+	}
+
+	g.out[v1].DeleteFirst(Vertex(v2))
+	g.in[v2].DeleteFirst(Vertex(v1))
+	g.numEdges--
+	return true
+}
+
+func (g *DirectedGraph) AddVertex() int {
+	g.out = append(g.out, linkedlist.New())
+	g.in = append(g.in, linkedlist.New())
+	g.numVertices++
+	return g.numVertices - 1
+}
+
+// RemoveVertex removes the vertex along with the edges directed into and out of it.
+// Renumbering the remaining vertices requires updating all the adjacency lists - O(V + E).
+func (g *DirectedGraph) RemoveVertex(v int) bool {
+	if !g.isValid(v) {
+		return false
+	}
+
+	for _, adjV := range g.out[v].SerializeIntoArray() {
+		if w := adjV.Get().(int); w != v {
+			g.in[w].DeleteFirst(Vertex(v))
+		}
+		g.numEdges--
+	}
+	for _, adjV := range g.in[v].SerializeIntoArray() {
+		// Self-loops were already counted as outgoing edges.
+		if w := adjV.Get().(int); w != v {
+			g.out[w].DeleteFirst(Vertex(v))
+			g.numEdges--
+		}
+	}
+
+	g.out = append(g.out[:v], g.out[v+1:]...)
+	g.in = append(g.in[:v], g.in[v+1:]...)
+	g.numVertices--
+	// Numbering every vertex above the removed one, one less.
+	var renumber = func(val util.Value) util.Value {
+		if w := val.Get().(int); w > v {
+			return Vertex(w - 1)
+		}
+		return val
+	}
+	for w := range g.out {
+		g.out[w].Map(renumber)
+		g.in[w].Map(renumber)
+	}
+	return true
+}
+
+// Adjacent returns the vertices pointed to by the given vertex.
+func (g DirectedGraph) Adjacent(v int) ([]int, bool) {
+	// we need to convert from []util.Value to []int.
+	var adjVertices []int
+	if !g.isValid(v) {
+		return adjVertices, false
+	}
+
+	for _, adjVertex := range g.out[v].SerializeIntoArray() {
+		adjVertices = append(adjVertices, adjVertex.Get().(int))
+	}
+
+	return adjVertices, true
+}
+
+// Degree returns the total number of edges, incoming and outgoing, incident on the vertex.
+func (g DirectedGraph) Degree(v int) (int, bool) {
+	if !g.isValid(v) {
+		return -1, false
+	}
+	return g.in[v].Size() + g.out[v].Size(), true
+}
+
+func (g DirectedGraph) InDegree(v int) (int, bool) {
+	if !g.isValid(v) {
+		return -1, false
+	}
+	return g.in[v].Size(), true
+}
+
+func (g DirectedGraph) OutDegree(v int) (int, bool) {
+	if !g.isValid(v) {
+		return -1, false
+	}
+	return g.out[v].Size(), true
+}
+
+func (g DirectedGraph) String() string {
+	var buf = new(bytes.Buffer)
+	for v, adjL := range g.out {
+		buf.WriteString(fmt.Sprintf("%d => ", v))
+		var adjVertices []int
+		for _, connectedV := range adjL.SerializeIntoArray() {
+			adjVertices = append(adjVertices, connectedV.Get().(int))
+		}
+		buf.WriteString(fmt.Sprintf("%v\n", adjVertices))
+	}
+	return buf.String()
+}
+
+// Reverse returns a new directed graph where every edge v->w is replaced by w->v.
+func (g DirectedGraph) Reverse() graphs.Digraph {
+	r := NewDirectedGraph(g.numVertices)
+	for v, adjL := range g.out {
+		for _, w := range adjL.SerializeIntoArray() {
+			r.AddEdge(w.Get().(int), v)
+		}
+	}
+	return r
+}
+
+func (g DirectedGraph) Dfs() []int {
+	result, _ := graphs.NewTraversal(&g).Dfs(context.Background())
+	return result
+}
+
+func (g DirectedGraph) Bfs() []int {
+	result, _ := graphs.NewTraversal(&g).Bfs(context.Background())
+	return result
+}
+
+// Walk runs a depth first walk from start, making the callbacks in visitor.
+// Return false if vertex does not exist.
+// See graphs.Visitor for how the edges are classified.
+func (g DirectedGraph) Walk(start int, visitor graphs.Visitor) bool {
+	return graphs.Walk(&g, start, visitor)
+}
+
+// ConnectedVertices returns all the vertices reachable from the source vertex.
+// All the vertices visited in a dfs starting at source are reachable from it.
+func (g DirectedGraph) ConnectedVertices(source int) ([]int, bool) {
+	connected, err := graphs.NewTraversal(&g).ConnectedVertices(context.Background(), source)
+	return connected, err == nil
+}
+
+// FindPath finds a directed path from source vertex to destination vertex.
+// The parents of the vertices visited in a dfs from the source are traced back from the destination.
+func (g DirectedGraph) FindPath(source, dest int) ([]int, bool) {
+	path, found, _ := graphs.NewTraversal(&g).FindPath(context.Background(), source, dest)
+	return path, found
+}
+
+// FindPathV2 finds a directed path from source vertex to destination vertex.
+// The path is built while traversing the graph, and vertices that lead to dead ends
+// are removed from it.
+func (g DirectedGraph) FindPathV2(source, dest int) ([]int, bool) {
+	return graphs.NewTraversal(&g).FindPathV2(source, dest)
+}
+
+// FindConnectedComponents returns the weakly connected components of the graph.
+// Two vertices are weakly connected if there is a path between them when the
+// direction of the edges is ignored.
+func (g DirectedGraph) FindConnectedComponents() [][]int {
+	connectedComponents, _ := g.weakTraversal().ConnectedComponents(context.Background())
+	return connectedComponents
+}
+
+// weakTraversal returns a traversal that ignores the direction of the edges, by exploring the
+// vertices pointing to every vertex along with the ones it points to.
+func (g DirectedGraph) weakTraversal() *graphs.Traversal {
+	return graphs.NewTraversalFunc(&g, g.neighbours)
+}
+
+// neighbours returns the vertices that v points to, followed by the vertices pointing to v.
+func (g DirectedGraph) neighbours(v int) []int {
+	var neighbours = make([]int, 0, g.out[v].Size()+g.in[v].Size())
+	for _, adjV := range append(g.out[v].SerializeIntoArray(), g.in[v].SerializeIntoArray()...) {
+		neighbours = append(neighbours, adjV.Get().(int))
+	}
+	return neighbours
+}
@@ -0,0 +1,283 @@
+package directed
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewDirectedGraph(t *testing.T) {
+	dg := NewDirectedGraph(13).(*DirectedGraph)
+	assert.NotNil(t, dg)
+	assert.Equal(t, dg.numVertices, 13)
+	assert.Equal(t, dg.numEdges, 0)
+}
+
+// tinyDGEdges returns the edges of tinyDG.txt.
+// The pairs are taken from https://algs4.cs.princeton.edu/42digraph/.
+func tinyDGEdges() [][]int {
+	return [][]int{
+		{4, 2}, {2, 3}, {3, 2}, {6, 0}, {0, 1}, {2, 0}, {11, 12}, {12, 9},
+		{9, 10}, {9, 11}, {7, 9}, {10, 12}, {11, 4}, {4, 3}, {3, 5}, {6, 8},
+		{8, 6}, {5, 4}, {0, 5}, {6, 4}, {6, 9}, {7, 6},
+	}
+}
+
+func getDirectedGraph(t *testing.T) graphs.Digraph {
+	dg := NewDirectedGraph(13)
+	assert.NotNil(t, dg)
+	for _, p := range tinyDGEdges() {
+		dg.AddEdge(p[0], p[1])
+	}
+	return dg
+}
+
+// isPath returns whether the given sequence of vertices is a directed path in the graph.
+func isPath(g graphs.Graph, path []int) bool {
+	for i := 0; i+1 < len(path); i++ {
+		adjL, _ := g.Adjacent(path[i])
+		var found = false
+		for _, w := range adjL {
+			if w == path[i+1] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAddEdge(t *testing.T) {
+	dg := NewDirectedGraph(13)
+	for _, p := range tinyDGEdges() {
+		assert.True(t, dg.AddEdge(p[0], p[1]))
+	}
+	assert.Equal(t, 22, dg.GetE())
+	assert.False(t, dg.AddEdge(14, 0))
+	assert.False(t, dg.AddEdge(0, -1))
+	assert.Equal(t, 22, dg.GetE())
+}
+
+func TestAdjacent(t *testing.T) {
+	dg := getDirectedGraph(t)
+	var expectedAdjLists = [][]int{
+		{5, 1},
+		nil,
+		{0, 3},
+		{5, 2},
+		{3, 2},
+		{4},
+		{9, 4, 8, 0},
+		{6, 9},
+		{6},
+		{11, 10},
+		{12},
+		{4, 12},
+		{9},
+	}
+	for i := 0; i < 13; i++ {
+		adjL, validVertex := dg.Adjacent(i)
+		assert.True(t, validVertex)
+		assert.Equal(t, expectedAdjLists[i], adjL)
+	}
+	_, validVertex := dg.Adjacent(13)
+	assert.False(t, validVertex)
+}
+
+func TestDegrees(t *testing.T) {
+	dg := getDirectedGraph(t)
+	var expectedInDegrees = []int{2, 1, 2, 2, 3, 2, 2, 0, 1, 3, 1, 1, 2}
+	var expectedOutDegrees = []int{2, 0, 2, 2, 2, 1, 4, 2, 1, 2, 1, 2, 1}
+	for i := 0; i < 13; i++ {
+		indeg, validVertex := dg.InDegree(i)
+		assert.True(t, validVertex)
+		assert.Equal(t, expectedInDegrees[i], indeg)
+
+		outdeg, validVertex := dg.OutDegree(i)
+		assert.True(t, validVertex)
+		assert.Equal(t, expectedOutDegrees[i], outdeg)
+
+		deg, validVertex := dg.Degree(i)
+		assert.True(t, validVertex)
+		assert.Equal(t, expectedInDegrees[i]+expectedOutDegrees[i], deg)
+	}
+	_, validVertex := dg.InDegree(13)
+	assert.False(t, validVertex)
+}
+
+func TestReverse(t *testing.T) {
+	dg := getDirectedGraph(t)
+	r := dg.Reverse()
+	assert.Equal(t, dg.GetV(), r.GetV())
+	assert.Equal(t, dg.GetE(), r.GetE())
+	for v := 0; v < dg.GetV(); v++ {
+		indeg, _ := dg.InDegree(v)
+		outdeg, _ := dg.OutDegree(v)
+		rIndeg, _ := r.InDegree(v)
+		rOutdeg, _ := r.OutDegree(v)
+		assert.Equal(t, indeg, rOutdeg)
+		assert.Equal(t, outdeg, rIndeg)
+
+		adjL, _ := dg.Adjacent(v)
+		for _, w := range adjL {
+			assert.True(t, isPath(r, []int{w, v}))
+		}
+	}
+}
+
+func TestPrintDfs(t *testing.T) {
+	dg := getDirectedGraph(t)
+	assert.Equal(t, []int{0, 5, 4, 3, 2, 1, 6, 9, 11, 12, 10, 8, 7}, dg.Dfs())
+}
+
+func TestPrintBfs(t *testing.T) {
+	dg := getDirectedGraph(t)
+	assert.Equal(t, []int{0, 5, 1, 4, 3, 2, 6, 9, 8, 11, 10, 12, 7}, dg.Bfs())
+}
+
+func TestConnectedVertices(t *testing.T) {
+	dg := getDirectedGraph(t)
+	for _, v := range []int{0, 2, 3, 4, 5} {
+		reachable, validVertex := dg.ConnectedVertices(v)
+		assert.True(t, validVertex)
+		assert.ElementsMatch(t, []int{0, 1, 2, 3, 4, 5}, reachable)
+	}
+
+	reachable, validVertex := dg.ConnectedVertices(1)
+	assert.True(t, validVertex)
+	assert.ElementsMatch(t, []int{1}, reachable)
+
+	reachable, validVertex = dg.ConnectedVertices(7)
+	assert.True(t, validVertex)
+	assert.Len(t, reachable, 13)
+
+	_, validVertex = dg.ConnectedVertices(13)
+	assert.False(t, validVertex)
+}
+
+func TestFindPath(t *testing.T) {
+	dg := getDirectedGraph(t)
+	path, found := dg.FindPath(7, 1)
+	assert.True(t, found)
+	assert.Equal(t, 7, path[0])
+	assert.Equal(t, 1, path[len(path)-1])
+	assert.True(t, isPath(dg, path))
+
+	// There are no edges out of 1.
+	_, found = dg.FindPath(1, 7)
+	assert.False(t, found)
+
+	// Nothing can reach 7.
+	_, found = dg.FindPath(0, 7)
+	assert.False(t, found)
+}
+
+func TestFindPathV2(t *testing.T) {
+	dg := getDirectedGraph(t)
+	for i := 0; i < 13; i++ {
+		reachable, _ := dg.ConnectedVertices(i)
+		for _, j := range reachable {
+			path, found := dg.FindPathV2(i, j)
+			assert.True(t, found)
+			assert.Equal(t, i, path[0])
+			assert.Equal(t, j, path[len(path)-1])
+			assert.True(t, isPath(dg, path))
+		}
+	}
+
+	_, found := dg.FindPathV2(1, 7)
+	assert.False(t, found)
+}
+
+func TestFindConnectedComponents(t *testing.T) {
+	dg := getDirectedGraph(t)
+	components := dg.FindConnectedComponents()
+	assert.Len(t, components, 1)
+	assert.Len(t, components[0], 13)
+
+	dg = NewDirectedGraph(5)
+	dg.AddEdge(0, 1)
+	dg.AddEdge(2, 1)
+	dg.AddEdge(3, 4)
+	assert.ElementsMatch(t, [][]int{
+		{0, 1, 2},
+		{3, 4},
+	}, dg.FindConnectedComponents())
+}
+
+func TestHasEdge(t *testing.T) {
+	dg := getDirectedGraph(t)
+	assert.True(t, dg.HasEdge(4, 2))
+	assert.False(t, dg.HasEdge(2, 4))
+	assert.False(t, dg.HasEdge(4, 13))
+	assert.False(t, dg.HasEdge(-1, 2))
+}
+
+func TestRemoveEdge(t *testing.T) {
+	dg := getDirectedGraph(t)
+	assert.False(t, dg.RemoveEdge(2, 4))
+	assert.True(t, dg.RemoveEdge(4, 2))
+	assert.Equal(t, 21, dg.GetE())
+	assert.False(t, dg.HasEdge(4, 2))
+	indeg, _ := dg.InDegree(2)
+	assert.Equal(t, 1, indeg)
+	outdeg, _ := dg.OutDegree(4)
+	assert.Equal(t, 1, outdeg)
+
+	// Only one of the parallel edges is removed.
+	dg.AddEdge(7, 9)
+	assert.True(t, dg.RemoveEdge(7, 9))
+	assert.True(t, dg.HasEdge(7, 9))
+
+	dg.AddEdge(1, 1)
+	assert.True(t, dg.RemoveEdge(1, 1))
+	deg, _ := dg.Degree(1)
+	assert.Equal(t, 1, deg)
+	assert.Equal(t, 21, dg.GetE())
+}
+
+func TestAddVertex(t *testing.T) {
+	dg := getDirectedGraph(t)
+	assert.Equal(t, 13, dg.AddVertex())
+	assert.Equal(t, 14, dg.GetV())
+	assert.True(t, dg.AddEdge(13, 7))
+	assert.Equal(t, 23, dg.GetE())
+	path, found := dg.FindPath(13, 0)
+	assert.True(t, found)
+	assert.Equal(t, 13, path[0])
+}
+
+func TestRemoveVertex(t *testing.T) {
+	dg := getDirectedGraph(t)
+	dg.AddEdge(6, 6)
+	assert.True(t, dg.RemoveVertex(6))
+	assert.Equal(t, 12, dg.GetV())
+
+	// Every edge not incident on 6 is kept, with the vertices above 6 numbered one less.
+	var renumber = func(v int) int {
+		if v > 6 {
+			return v - 1
+		}
+		return v
+	}
+	var numEdges = 0
+	for _, p := range tinyDGEdges() {
+		if (p[0] == 6) || (p[1] == 6) {
+			continue
+		}
+		numEdges++
+		assert.True(t, dg.HasEdge(renumber(p[0]), renumber(p[1])), "edge %v", p)
+	}
+	assert.Equal(t, numEdges, dg.GetE())
+	var total = 0
+	for v := 0; v < dg.GetV(); v++ {
+		indeg, _ := dg.InDegree(v)
+		outdeg, _ := dg.OutDegree(v)
+		total += indeg + outdeg
+	}
+	assert.Equal(t, 2*numEdges, total)
+	assert.False(t, dg.RemoveVertex(12))
+}
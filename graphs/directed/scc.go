@@ -0,0 +1,181 @@
+package directed
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/stack"
+)
+
+// StronglyConnectedComponents is the result of finding the strongly connected components of a
+// directed graph. Two vertices are strongly connected if they are reachable from each other.
+//
+// id[v] is the component that the vertex v belongs to. Components are numbered from 0 to Count()-1.
+// The graph itself is not kept, so the components are not affected by later changes to it.
+type StronglyConnectedComponents struct {
+	id    []int
+	count int
+}
+
+// Count returns the number of strongly connected components.
+func (scc StronglyConnectedComponents) Count() int {
+	return scc.count
+}
+
+// ID returns the component that the given vertex belongs to.
+// Return false if vertex does not exist.
+func (scc StronglyConnectedComponents) ID(v int) (int, bool) {
+	if (v < 0) || (v >= len(scc.id)) {
+		return -1, false
+	}
+	return scc.id[v], true
+}
+
+// StronglyConnected returns whether the two vertices are in the same strongly connected component.
+func (scc StronglyConnectedComponents) StronglyConnected(u, v int) bool {
+	idU, validU := scc.ID(u)
+	idV, validV := scc.ID(v)
+	return validU && validV && (idU == idV)
+}
+
+// Components returns the vertices in each of the strongly connected components.
+// The i-th entry holds the vertices of the component with id i.
+func (scc StronglyConnectedComponents) Components() [][]int {
+	var components = make([][]int, scc.count)
+	for i := range components {
+		components[i] = make([]int, 0, 0)
+	}
+	for v, id := range scc.id {
+		components[id] = append(components[id], v)
+	}
+	return components
+}
+
+// Condensation returns the graph obtained by contracting every strongly connected component into
+// a single vertex. Vertex i of the condensation is the component with id i, and there is an edge
+// i->j if there is an edge from a vertex in component i to a vertex in component j.
+// The condensation is always a DAG.
+//
+// g must be the graph that the components were found in, unchanged since.
+// Return false if g does not have the same number of vertices.
+func (scc StronglyConnectedComponents) Condensation(g graphs.Digraph) (graphs.Digraph, bool) {
+	if g.GetV() != len(scc.id) {
+		return nil, false
+	}
+
+	var condensation = NewDirectedGraph(scc.count)
+	var added = make(map[[2]int]struct{})
+	for v := range scc.id {
+		adjList, _ := g.Adjacent(v)
+		for _, w := range adjList {
+			var edge = [2]int{scc.id[v], scc.id[w]}
+			if edge[0] == edge[1] {
+				continue
+			}
+			if _, ok := added[edge]; !ok {
+				condensation.AddEdge(edge[0], edge[1])
+				added[edge] = struct{}{}
+			}
+		}
+	}
+	return condensation, true
+}
+
+func (g DirectedGraph) newStronglyConnectedComponents() *StronglyConnectedComponents {
+	var scc = &StronglyConnectedComponents{
+		id:    make([]int, len(g.out)),
+		count: 0,
+	}
+	for v := range scc.id {
+		scc.id[v] = -1
+	}
+	return scc
+}
+
+// KosarajuSCC finds the strongly connected components using the Kosaraju-Sharir algorithm.
+// Algorithm taken from https://algs4.cs.princeton.edu/42digraph/.
+//
+// The vertices are visited in the reverse postorder of the reversed graph. This ensures that
+// a dfs starting from a vertex can only reach the vertices in its own component and the
+// components that have already been found.
+func (g DirectedGraph) KosarajuSCC() *StronglyConnectedComponents {
+	var scc = g.newStronglyConnectedComponents()
+	var postorder = g.Reverse().(*DirectedGraph).postorder()
+
+	var walker = graphs.NewWalker(&g)
+	for i := len(postorder) - 1; i >= 0; i-- {
+		v := postorder[i]
+		if walker.Visited(v) {
+			continue
+		}
+		walker.Walk(v, graphs.Visitor{
+			PreVisit: func(w int) graphs.WalkSignal {
+				scc.id[w] = scc.count
+				return graphs.Continue
+			},
+		})
+		scc.count++
+	}
+	return scc
+}
+
+// TarjanSCC finds the strongly connected components using Tarjan's algorithm.
+// Algorithm taken from https://algs4.cs.princeton.edu/42digraph/.
+//
+// Every vertex is assigned a preorder number during a dfs, and low[v] is the smallest preorder
+// number reachable from v through the vertices that are still on the stack. A vertex whose low
+// equals its own preorder number is the root of a component, which consists of all the vertices
+// above it on the stack.
+func (g DirectedGraph) TarjanSCC() *StronglyConnectedComponents {
+	var scc = g.newStronglyConnectedComponents()
+	var pre = make([]int, len(g.out))
+	var low = make([]int, len(g.out))
+	var parent = make([]int, len(g.out))
+	var onStack = make([]bool, len(g.out))
+	// Every vertex is pushed exactly once.
+	var vertexStack = stack.NewArrayStack(len(g.out))
+	var preCounter = 0
+	// An edge v->w to a vertex w still on the stack can lower low[v].
+	// Edges to vertices in components that have already been found cannot.
+	var seen = func(v, w int) graphs.WalkSignal {
+		if onStack[w] && (pre[w] < low[v]) {
+			low[v] = pre[w]
+		}
+		return graphs.Continue
+	}
+
+	graphs.WalkAll(&g, graphs.Visitor{
+		PreVisit: func(v int) graphs.WalkSignal {
+			pre[v] = preCounter
+			low[v] = preCounter
+			preCounter++
+			vertexStack.Push(v)
+			onStack[v] = true
+			return graphs.Continue
+		},
+		TreeEdge: func(v, w int) graphs.WalkSignal {
+			parent[w] = v
+			return graphs.Continue
+		},
+		BackEdge:    seen,
+		ForwardEdge: seen,
+		CrossEdge:   seen,
+		PostVisit: func(v int) graphs.WalkSignal {
+			if low[v] == pre[v] {
+				// v is the root of a component.
+				for {
+					w, _ := vertexStack.Pop()
+					onStack[w] = false
+					scc.id[w] = scc.count
+					if w == v {
+						break
+					}
+				}
+				scc.count++
+			} else if low[v] < low[parent[v]] {
+				// v is not the root of a walk, as the root of every walk is the root of a component.
+				low[parent[v]] = low[v]
+			}
+			return graphs.Continue
+		},
+	})
+	return scc
+}
@@ -0,0 +1,95 @@
+package directed
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func testStronglyConnectedComponents(t *testing.T, dg graphs.Digraph, scc *StronglyConnectedComponents) {
+	// The components of tinyDG.txt.
+	assert.Equal(t, 5, scc.Count())
+	assert.ElementsMatch(t, [][]int{
+		{1},
+		{0, 2, 3, 4, 5},
+		{9, 10, 11, 12},
+		{6, 8},
+		{7},
+	}, scc.Components())
+
+	assert.True(t, scc.StronglyConnected(0, 5))
+	assert.True(t, scc.StronglyConnected(9, 12))
+	assert.True(t, scc.StronglyConnected(7, 7))
+	// 0 is reachable from 6 but not the other way around.
+	assert.False(t, scc.StronglyConnected(6, 0))
+	assert.False(t, scc.StronglyConnected(0, 13))
+
+	id0, validVertex := scc.ID(0)
+	assert.True(t, validVertex)
+	id5, _ := scc.ID(5)
+	assert.Equal(t, id0, id5)
+	_, validVertex = scc.ID(-1)
+	assert.False(t, validVertex)
+
+	// The condensation has an edge between components i and j if some vertex in i points to
+	// some vertex in j.
+	c, ok := scc.Condensation(dg)
+	assert.True(t, ok)
+	condensation := c.(*DirectedGraph)
+	assert.Equal(t, 5, condensation.GetV())
+	assert.False(t, condensation.HasCycle())
+	var id = func(v int) int {
+		i, _ := scc.ID(v)
+		return i
+	}
+	var expectedEdges = [][]int{
+		{id(0), id(1)},
+		{id(6), id(0)},
+		{id(6), id(9)},
+		{id(9), id(0)},
+		{id(7), id(6)},
+		{id(7), id(9)},
+	}
+	assert.Equal(t, len(expectedEdges), condensation.GetE())
+	for _, e := range expectedEdges {
+		assert.True(t, isPath(condensation, e))
+	}
+
+	_, ok = scc.Condensation(NewDirectedGraph(3))
+	assert.False(t, ok)
+}
+
+func TestDirectedGraph_KosarajuSCC(t *testing.T) {
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	testStronglyConnectedComponents(t, dg, dg.KosarajuSCC())
+}
+
+func TestDirectedGraph_TarjanSCC(t *testing.T) {
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	testStronglyConnectedComponents(t, dg, dg.TarjanSCC())
+}
+
+func TestStronglyConnectedComponents_GraphModified(t *testing.T) {
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	for _, scc := range []*StronglyConnectedComponents{dg.KosarajuSCC(), dg.TarjanSCC()} {
+		// Changing the graph afterwards does not change the components found.
+		var components = scc.Components()
+		assert.True(t, dg.AddEdge(0, 7))
+		assert.True(t, dg.RemoveEdge(2, 3))
+		assert.Equal(t, components, scc.Components())
+		assert.False(t, scc.StronglyConnected(0, 7))
+		assert.True(t, scc.StronglyConnected(2, 3))
+		assert.True(t, dg.RemoveEdge(0, 7))
+		assert.True(t, dg.AddEdge(2, 3))
+	}
+}
+
+func TestStronglyConnectedComponents_DAG(t *testing.T) {
+	// Every vertex in a DAG is its own component.
+	dag := getDAG(t).(*DirectedGraph)
+	for _, scc := range []*StronglyConnectedComponents{dag.KosarajuSCC(), dag.TarjanSCC()} {
+		assert.Equal(t, 13, scc.Count())
+		condensation, _ := scc.Condensation(dag)
+		assert.Equal(t, dag.GetE(), condensation.GetE())
+	}
+}
@@ -0,0 +1,162 @@
+package directed
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/queue/fifo"
+)
+
+// CycleError is returned when the graph is required to be a directed acyclic graph (DAG)
+// but contains a directed cycle.
+type CycleError struct {
+	// Cycle is the sequence of vertices on the cycle.
+	// The first and the last vertices are the same.
+	Cycle []int
+}
+
+func (e CycleError) Error() string {
+	var buf = new(bytes.Buffer)
+	buf.WriteString("graph is not a DAG, found cycle: ")
+	for i, v := range e.Cycle {
+		if i > 0 {
+			buf.WriteString(" -> ")
+		}
+		buf.WriteString(fmt.Sprintf("%d", v))
+	}
+	return buf.String()
+}
+
+// HasCycle returns whether the graph contains a directed cycle.
+func (g DirectedGraph) HasCycle() bool {
+	_, found := g.findCycle()
+	return found
+}
+
+// Cycle returns a directed cycle in the graph if one exists.
+// The first and the last vertices of the cycle are the same.
+// An empty cycle is returned if the graph is a DAG.
+func (g DirectedGraph) Cycle() []int {
+	cycle, _ := g.findCycle()
+	return cycle
+}
+
+// findCycle runs a dfs looking for a back edge.
+// A back edge v->w to a vertex w that is still being explored closes a cycle, which is then traced
+// back from v to w using the parent of each vertex.
+func (g DirectedGraph) findCycle() ([]int, bool) {
+	var walker = graphs.NewWalker(&g)
+	var parentTracker = make([]int, len(g.out))
+	var cycle = make([]int, 0, 0)
+	for v := range g.out {
+		if walker.Visited(v) {
+			continue
+		}
+		if findCycleDfs(walker, v, parentTracker, &cycle) {
+			return cycle, true
+		}
+	}
+	return cycle, false
+}
+
+func findCycleDfs(
+	walker *graphs.Walker,
+	source int,
+	parentTracker []int,
+	cycle *[]int) bool {
+
+	var found = false
+	walker.Walk(source, graphs.Visitor{
+		TreeEdge: func(v, w int) graphs.WalkSignal {
+			parentTracker[w] = v
+			return graphs.Continue
+		},
+		BackEdge: func(v, w int) graphs.WalkSignal {
+			// Found the cycle w -> ... -> v -> w.
+			// The vertices are traced back from v, and then reversed.
+			*cycle = append(*cycle, w)
+			for x := v; x != w; x = parentTracker[x] {
+				*cycle = append(*cycle, x)
+			}
+			*cycle = append(*cycle, w)
+			for i, j := 0, len(*cycle)-1; i < j; i, j = i+1, j-1 {
+				(*cycle)[i], (*cycle)[j] = (*cycle)[j], (*cycle)[i]
+			}
+			found = true
+			return graphs.Stop
+		},
+	})
+	return found
+}
+
+// TopologicalSort returns the vertices in an order such that for every edge v->w,
+// v comes before w.
+// Returns a CycleError if the graph is not a DAG, as no such order exists.
+//
+// The order is the reverse of the order in which a dfs finishes with the vertices.
+// A vertex is only finished after all the vertices reachable from it are finished.
+func (g DirectedGraph) TopologicalSort() ([]int, error) {
+	if cycle, found := g.findCycle(); found {
+		return []int{}, &CycleError{Cycle: cycle}
+	}
+
+	var postorder = g.postorder()
+
+	var order = make([]int, len(postorder))
+	for i, v := range postorder {
+		order[len(postorder)-i-1] = v
+	}
+	return order, nil
+}
+
+// postorder returns the vertices in the order in which a dfs of the whole graph finishes with them.
+func (g DirectedGraph) postorder() []int {
+	var postorder = make([]int, 0, len(g.out))
+	graphs.WalkAll(&g, graphs.Visitor{
+		PostVisit: func(v int) graphs.WalkSignal {
+			postorder = append(postorder, v)
+			return graphs.Continue
+		},
+	})
+	return postorder
+}
+
+// TopologicalSortKahn returns the vertices in an order such that for every edge v->w,
+// v comes before w.
+// Returns a CycleError if the graph is not a DAG, as no such order exists.
+//
+// Vertices with no incoming edges are queued and removed from the graph one at a time, which
+// could result in other vertices having no incoming edges. If the queue becomes empty before all
+// the vertices are removed, then the remaining vertices each have an incoming edge and therefore
+// contain a cycle.
+func (g DirectedGraph) TopologicalSortKahn() ([]int, error) {
+	var inDegree = make([]int, len(g.out))
+	// Every vertex is queued exactly once.
+	var next = fifo.NewLinearQueueArr(len(g.out))
+	for v := range g.in {
+		inDegree[v] = g.in[v].Size()
+		if inDegree[v] == 0 {
+			next.Enqueue(Vertex(v))
+		}
+	}
+
+	var order = make([]int, 0, len(g.out))
+	for !next.IsEmpty() {
+		nextV, _ := next.Dequeue()
+		v := nextV.Get().(int)
+		order = append(order, v)
+		adjList, _ := g.Adjacent(v)
+		for _, w := range adjList {
+			inDegree[w]--
+			if inDegree[w] == 0 {
+				next.Enqueue(Vertex(w))
+			}
+		}
+	}
+
+	if len(order) < len(g.out) {
+		cycle, _ := g.findCycle()
+		return []int{}, &CycleError{Cycle: cycle}
+	}
+	return order, nil
+}
@@ -0,0 +1,98 @@
+package directed
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// getDAG returns the graph in tinyDAG.txt.
+// The pairs are taken from https://algs4.cs.princeton.edu/42digraph/.
+func getDAG(t *testing.T) graphs.Digraph {
+	dg := NewDirectedGraph(13)
+	assert.NotNil(t, dg)
+	var pairs = [][]int{
+		{2, 3}, {0, 6}, {0, 1}, {2, 0}, {11, 12}, {9, 12}, {9, 10}, {9, 11},
+		{3, 5}, {8, 7}, {5, 4}, {0, 5}, {6, 4}, {6, 9}, {7, 6},
+	}
+	for _, p := range pairs {
+		dg.AddEdge(p[0], p[1])
+	}
+	return dg
+}
+
+// testTopologicalOrder checks that every vertex appears exactly once and that
+// v comes before w for every edge v->w.
+func testTopologicalOrder(t *testing.T, g graphs.Graph, order []int) {
+	assert.Len(t, order, g.GetV())
+	var position = make(map[int]int)
+	for i, v := range order {
+		position[v] = i
+	}
+	assert.Len(t, position, g.GetV())
+	for v := 0; v < g.GetV(); v++ {
+		adjL, _ := g.Adjacent(v)
+		for _, w := range adjL {
+			assert.Less(t, position[v], position[w])
+		}
+	}
+}
+
+// testCycle checks that the given sequence of vertices is a directed cycle in the graph.
+func testCycle(t *testing.T, g graphs.Graph, cycle []int) {
+	assert.True(t, len(cycle) >= 2)
+	assert.Equal(t, cycle[0], cycle[len(cycle)-1])
+	assert.True(t, isPath(g, cycle))
+}
+
+func TestDirectedGraph_HasCycle(t *testing.T) {
+	dag := getDAG(t).(*DirectedGraph)
+	assert.False(t, dag.HasCycle())
+	assert.Empty(t, dag.Cycle())
+
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	assert.True(t, dg.HasCycle())
+	testCycle(t, dg, dg.Cycle())
+
+	// Self-loop.
+	dg = NewDirectedGraph(2).(*DirectedGraph)
+	dg.AddEdge(0, 1)
+	dg.AddEdge(1, 1)
+	assert.Equal(t, []int{1, 1}, dg.Cycle())
+}
+
+func TestDirectedGraph_TopologicalSort(t *testing.T) {
+	dag := getDAG(t).(*DirectedGraph)
+	order, err := dag.TopologicalSort()
+	assert.NoError(t, err)
+	testTopologicalOrder(t, dag, order)
+
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	order, err = dg.TopologicalSort()
+	assert.Empty(t, order)
+	assert.Error(t, err)
+	cycleErr, ok := err.(*CycleError)
+	assert.True(t, ok)
+	testCycle(t, dg, cycleErr.Cycle)
+	assert.Contains(t, err.Error(), "not a DAG")
+}
+
+func TestDirectedGraph_TopologicalSortKahn(t *testing.T) {
+	dag := getDAG(t).(*DirectedGraph)
+	order, err := dag.TopologicalSortKahn()
+	assert.NoError(t, err)
+	testTopologicalOrder(t, dag, order)
+
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	order, err = dg.TopologicalSortKahn()
+	assert.Empty(t, order)
+	assert.Error(t, err)
+	cycleErr, ok := err.(*CycleError)
+	assert.True(t, ok)
+	testCycle(t, dg, cycleErr.Cycle)
+
+	// Empty graph.
+	order, err = NewDirectedGraph(0).(*DirectedGraph).TopologicalSortKahn()
+	assert.NoError(t, err)
+	assert.Empty(t, order)
+}
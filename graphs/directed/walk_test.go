@@ -0,0 +1,150 @@
+package directed
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// edgeCounter returns a visitor that counts the edges of each kind.
+func edgeCounter(counts map[string]int) graphs.Visitor {
+	var count = func(kind string) func(int, int) graphs.WalkSignal {
+		return func(int, int) graphs.WalkSignal {
+			counts[kind]++
+			return graphs.Continue
+		}
+	}
+	return graphs.Visitor{
+		TreeEdge:    count("tree"),
+		BackEdge:    count("back"),
+		ForwardEdge: count("forward"),
+		CrossEdge:   count("cross"),
+	}
+}
+
+func TestDirectedGraph_Walk(t *testing.T) {
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	var preorder, postorder []int
+	var visitor = graphs.Visitor{
+		PreVisit: func(v int) graphs.WalkSignal {
+			preorder = append(preorder, v)
+			return graphs.Continue
+		},
+		PostVisit: func(v int) graphs.WalkSignal {
+			postorder = append(postorder, v)
+			return graphs.Continue
+		},
+	}
+	assert.True(t, dg.Walk(7, visitor))
+	connected, _ := dg.ConnectedVertices(7)
+	assert.Equal(t, connected, preorder)
+	assert.ElementsMatch(t, preorder, postorder)
+	assert.Equal(t, 7, postorder[len(postorder)-1])
+
+	assert.False(t, dg.Walk(13, visitor))
+	assert.False(t, dg.Walk(-1, visitor))
+}
+
+func TestDirectedGraph_WalkEdgeKinds(t *testing.T) {
+	// 0->1->2->0 is a cycle, 0->2 is a forward edge and 3->1 is a cross edge.
+	dg := NewDirectedGraph(4)
+	for _, p := range [][]int{{0, 1}, {1, 2}, {2, 0}, {0, 2}, {3, 1}, {3, 3}} {
+		dg.AddEdge(p[0], p[1])
+	}
+	var kinds = make(map[[2]int]string)
+	var record = func(kind string) func(int, int) graphs.WalkSignal {
+		return func(v, w int) graphs.WalkSignal {
+			kinds[[2]int{v, w}] = kind
+			return graphs.Continue
+		}
+	}
+	graphs.WalkAll(dg, graphs.Visitor{
+		TreeEdge:    record("tree"),
+		BackEdge:    record("back"),
+		ForwardEdge: record("forward"),
+		CrossEdge:   record("cross"),
+	})
+	// Adjacent returns the most recently added edge first, so 0->2 is explored before 0->1.
+	var expected = map[[2]int]string{
+		{0, 2}: "tree",
+		{2, 0}: "back",
+		{0, 1}: "tree",
+		{1, 2}: "cross",
+		{3, 3}: "back",
+		{3, 1}: "cross",
+	}
+	assert.Equal(t, expected, kinds)
+}
+
+func TestDirectedGraph_WalkAll(t *testing.T) {
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	var preorder []int
+	var counts = make(map[string]int)
+	var visitor = edgeCounter(counts)
+	visitor.PreVisit = func(v int) graphs.WalkSignal {
+		preorder = append(preorder, v)
+		return graphs.Continue
+	}
+	graphs.WalkAll(dg, visitor)
+	assert.Equal(t, dg.Dfs(), preorder)
+	assert.Equal(t, dg.GetE(), counts["tree"]+counts["back"]+counts["forward"]+counts["cross"])
+	// Every vertex other than the starts 0, 6 and 7 is reached by a tree edge.
+	assert.Equal(t, dg.GetV()-3, counts["tree"])
+	assert.True(t, counts["back"] > 0)
+}
+
+func TestDirectedGraph_WalkTopologicalSort(t *testing.T) {
+	// A DAG has no back edges, and the reverse postorder is a topological order.
+	dag := getDAG(t).(*DirectedGraph)
+	var postorder []int
+	var counts = make(map[string]int)
+	var visitor = edgeCounter(counts)
+	visitor.PostVisit = func(v int) graphs.WalkSignal {
+		postorder = append([]int{v}, postorder...)
+		return graphs.Continue
+	}
+	graphs.WalkAll(dag, visitor)
+	assert.Zero(t, counts["back"])
+	expected, err := dag.TopologicalSort()
+	assert.NoError(t, err)
+	assert.Equal(t, expected, postorder)
+}
+
+func TestDirectedGraph_WalkSignals(t *testing.T) {
+	dg := getDirectedGraph(t).(*DirectedGraph)
+	var preorder []int
+	var visitor = graphs.Visitor{
+		PreVisit: func(v int) graphs.WalkSignal {
+			preorder = append(preorder, v)
+			if len(preorder) == 3 {
+				return graphs.Stop
+			}
+			return graphs.Continue
+		},
+		PostVisit: func(v int) graphs.WalkSignal {
+			assert.Fail(t, "walk should have stopped", "post-visit of %d", v)
+			return graphs.Continue
+		},
+	}
+	graphs.WalkAll(dg, visitor)
+	assert.Equal(t, dg.Dfs()[:3], preorder)
+
+	// 7 is only reachable from itself, and 6 and 8 are only reachable through 6.
+	preorder = nil
+	dg.Walk(7, graphs.Visitor{
+		PreVisit: func(v int) graphs.WalkSignal {
+			preorder = append(preorder, v)
+			return graphs.Continue
+		},
+		TreeEdge: func(v, w int) graphs.WalkSignal {
+			if w == 6 {
+				return graphs.SkipSubtree
+			}
+			return graphs.Continue
+		},
+	})
+	connected, _ := dg.ConnectedVertices(7)
+	assert.Len(t, preorder, len(connected)-2)
+	assert.NotContains(t, preorder, 6)
+	assert.NotContains(t, preorder, 8)
+}
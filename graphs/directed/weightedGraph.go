@@ -0,0 +1,145 @@
+package directed
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/linkedlist"
+)
+
+// WeightedDirectedGraph is a directed graph where every edge has a weight.
+// Both the outgoing and the incoming edges of every vertex are stored.
+type WeightedDirectedGraph struct {
+	out         []*linkedlist.LinkedList // edges directed out of each vertex.
+	in          []*linkedlist.LinkedList // edges directed into each vertex.
+	numVertices int
+	numEdges    int
+}
+
+// NewWeightedDirectedGraph creates an edge-weighted directed graph with the provided number of vertices.
+// Note that this graph will have no edges to begin with.
+func NewWeightedDirectedGraph(v int) graphs.WeightedDigraph {
+	g := &WeightedDirectedGraph{
+		out:         make([]*linkedlist.LinkedList, v),
+		in:          make([]*linkedlist.LinkedList, v),
+		numVertices: v,
+		numEdges:    0,
+	}
+
+	for i := 0; i < g.numVertices; i++ {
+		g.out[i] = linkedlist.New()
+		g.in[i] = linkedlist.New()
+	}
+
+	return g
+}
+
+func (g WeightedDirectedGraph) GetV() int {
+	return g.numVertices
+}
+
+func (g WeightedDirectedGraph) GetE() int {
+	return g.numEdges
+}
+
+// isValid returns whether the given vertex exists in the graph.
+func (g WeightedDirectedGraph) isValid(v int) bool {
+	return (v >= 0) && (v < len(g.out))
+}
+
+// AddWeightedEdge adds the edge v1->v2 with the given weight.
+func (g *WeightedDirectedGraph) AddWeightedEdge(v1 int, v2 int, weight float64) bool {
+	if !g.isValid(v1) || !g.isValid(v2) {
+		return false
+	}
+
+	e := graphs.NewEdge(v1, v2, weight)
+	g.out[v1].AddToFront(e)
+	g.in[v2].AddToFront(e)
+	g.numEdges++
+	return true
+}
+
+// Adjacent returns the vertices pointed to by the given vertex.
+func (g WeightedDirectedGraph) Adjacent(v int) ([]int, bool) {
+	var adjVertices []int
+	if !g.isValid(v) {
+		return adjVertices, false
+	}
+
+	for _, e := range g.out[v].SerializeIntoArray() {
+		adjVertices = append(adjVertices, e.Get().(graphs.Edge).To())
+	}
+	return adjVertices, true
+}
+
+// AdjacentEdges returns the edges directed out of the given vertex.
+func (g WeightedDirectedGraph) AdjacentEdges(v int) ([]graphs.Edge, bool) {
+	var adjEdges []graphs.Edge
+	if !g.isValid(v) {
+		return adjEdges, false
+	}
+
+	for _, e := range g.out[v].SerializeIntoArray() {
+		adjEdges = append(adjEdges, e.Get().(graphs.Edge))
+	}
+	return adjEdges, true
+}
+
+// Degree returns the total number of edges, incoming and outgoing, incident on the vertex.
+func (g WeightedDirectedGraph) Degree(v int) (int, bool) {
+	if !g.isValid(v) {
+		return -1, false
+	}
+	return g.in[v].Size() + g.out[v].Size(), true
+}
+
+func (g WeightedDirectedGraph) InDegree(v int) (int, bool) {
+	if !g.isValid(v) {
+		return -1, false
+	}
+	return g.in[v].Size(), true
+}
+
+func (g WeightedDirectedGraph) OutDegree(v int) (int, bool) {
+	if !g.isValid(v) {
+		return -1, false
+	}
+	return g.out[v].Size(), true
+}
+
+func (g WeightedDirectedGraph) Edges() []graphs.Edge {
+	var edges = make([]graphs.Edge, 0, g.numEdges)
+	for v := range g.out {
+		adjEdges, _ := g.AdjacentEdges(v)
+		edges = append(edges, adjEdges...)
+	}
+	return edges
+}
+
+func (g WeightedDirectedGraph) TotalWeight() float64 {
+	var total = 0.0
+	for _, e := range g.Edges() {
+		total += e.Weight()
+	}
+	return total
+}
+
+// Reverse returns a new graph where every edge v->w is replaced by w->v with the same weight.
+func (g WeightedDirectedGraph) Reverse() graphs.WeightedDigraph {
+	r := NewWeightedDirectedGraph(g.numVertices)
+	for _, e := range g.Edges() {
+		r.AddWeightedEdge(e.To(), e.From(), e.Weight())
+	}
+	return r
+}
+
+func (g WeightedDirectedGraph) String() string {
+	var buf = new(bytes.Buffer)
+	for v := range g.out {
+		buf.WriteString(fmt.Sprintf("%d => ", v))
+		adjEdges, _ := g.AdjacentEdges(v)
+		buf.WriteString(fmt.Sprintf("%v\n", adjEdges))
+	}
+	return buf.String()
+}
@@ -0,0 +1,101 @@
+package directed
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// tinyEWDEdges returns the edges of tinyEWD.txt.
+// The edges are taken from https://algs4.cs.princeton.edu/44sp/.
+func tinyEWDEdges() []graphs.Edge {
+	return []graphs.Edge{
+		graphs.NewEdge(4, 5, 0.35), graphs.NewEdge(5, 4, 0.35), graphs.NewEdge(4, 7, 0.37),
+		graphs.NewEdge(5, 7, 0.28), graphs.NewEdge(7, 5, 0.28), graphs.NewEdge(5, 1, 0.32),
+		graphs.NewEdge(0, 4, 0.38), graphs.NewEdge(0, 2, 0.26), graphs.NewEdge(7, 3, 0.39),
+		graphs.NewEdge(1, 3, 0.29), graphs.NewEdge(2, 7, 0.34), graphs.NewEdge(6, 2, 0.40),
+		graphs.NewEdge(3, 6, 0.52), graphs.NewEdge(6, 0, 0.58), graphs.NewEdge(6, 4, 0.93),
+	}
+}
+
+func getWeightedDirectedGraph(t *testing.T) graphs.WeightedDigraph {
+	wg := NewWeightedDirectedGraph(8)
+	assert.NotNil(t, wg)
+	for _, e := range tinyEWDEdges() {
+		wg.AddWeightedEdge(e.From(), e.To(), e.Weight())
+	}
+	return wg
+}
+
+func TestNewWeightedDirectedGraph(t *testing.T) {
+	wg := NewWeightedDirectedGraph(8).(*WeightedDirectedGraph)
+	assert.NotNil(t, wg)
+	assert.Equal(t, 8, wg.GetV())
+	assert.Equal(t, 0, wg.GetE())
+	assert.Empty(t, wg.Edges())
+}
+
+func TestWeightedDirectedGraph_AddWeightedEdge(t *testing.T) {
+	wg := NewWeightedDirectedGraph(8)
+	for _, e := range tinyEWDEdges() {
+		assert.True(t, wg.AddWeightedEdge(e.From(), e.To(), e.Weight()))
+	}
+	assert.Equal(t, 15, wg.GetE())
+	assert.False(t, wg.AddWeightedEdge(8, 0, 1.0))
+	assert.Equal(t, 15, wg.GetE())
+}
+
+func TestWeightedDirectedGraph_Adjacent(t *testing.T) {
+	wg := getWeightedDirectedGraph(t)
+	adjL, validVertex := wg.Adjacent(6)
+	assert.True(t, validVertex)
+	assert.Equal(t, []int{4, 0, 2}, adjL)
+
+	adjEdges, validVertex := wg.AdjacentEdges(6)
+	assert.True(t, validVertex)
+	assert.Equal(t, []graphs.Edge{
+		graphs.NewEdge(6, 4, 0.93),
+		graphs.NewEdge(6, 0, 0.58),
+		graphs.NewEdge(6, 2, 0.40),
+	}, adjEdges)
+
+	_, validVertex = wg.AdjacentEdges(8)
+	assert.False(t, validVertex)
+}
+
+func TestWeightedDirectedGraph_Degrees(t *testing.T) {
+	wg := getWeightedDirectedGraph(t)
+	var expectedInDegrees = []int{1, 1, 2, 2, 3, 2, 1, 3}
+	var expectedOutDegrees = []int{2, 1, 1, 1, 2, 3, 3, 2}
+	for v := 0; v < 8; v++ {
+		indeg, validVertex := wg.InDegree(v)
+		assert.True(t, validVertex)
+		assert.Equal(t, expectedInDegrees[v], indeg)
+
+		outdeg, validVertex := wg.OutDegree(v)
+		assert.True(t, validVertex)
+		assert.Equal(t, expectedOutDegrees[v], outdeg)
+
+		deg, validVertex := wg.Degree(v)
+		assert.True(t, validVertex)
+		assert.Equal(t, indeg+outdeg, deg)
+	}
+}
+
+func TestWeightedDirectedGraph_Edges(t *testing.T) {
+	wg := getWeightedDirectedGraph(t)
+	assert.ElementsMatch(t, tinyEWDEdges(), wg.Edges())
+	assert.InDelta(t, 6.04, wg.TotalWeight(), 1e-9)
+}
+
+func TestWeightedDirectedGraph_Reverse(t *testing.T) {
+	wg := getWeightedDirectedGraph(t)
+	r := wg.Reverse()
+	assert.Equal(t, wg.GetE(), r.GetE())
+	var reversed []graphs.Edge
+	for _, e := range tinyEWDEdges() {
+		reversed = append(reversed, e.Reverse())
+	}
+	assert.ElementsMatch(t, reversed, r.Edges())
+	assert.InDelta(t, wg.TotalWeight(), r.TotalWeight(), 1e-9)
+}
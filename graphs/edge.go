@@ -0,0 +1,68 @@
+package graphs
+
+import "fmt"
+
+// Edge is a weighted edge between two vertices.
+// Implements util.Value so that it can be stored in the other data structures.
+//
+// For directed graphs, the edge points from From() to To().
+// For undirected graphs, the two endpoints can be retrieved using Either() and Other().
+type Edge struct {
+	from   int
+	to     int
+	weight float64
+}
+
+// NewEdge returns an edge between the two vertices with the given weight.
+func NewEdge(from, to int, weight float64) Edge {
+	return Edge{
+		from:   from,
+		to:     to,
+		weight: weight,
+	}
+}
+
+func (e Edge) Get() interface{} {
+	return e
+}
+
+// From returns the vertex at the tail of the edge.
+func (e Edge) From() int {
+	return e.from
+}
+
+// To returns the vertex at the head of the edge.
+func (e Edge) To() int {
+	return e.to
+}
+
+// Weight returns the weight of the edge.
+func (e Edge) Weight() float64 {
+	return e.weight
+}
+
+// Either returns one of the endpoints of the edge.
+func (e Edge) Either() int {
+	return e.from
+}
+
+// Other returns the endpoint of the edge that is not the provided vertex.
+// Return false if the provided vertex is not an endpoint of the edge.
+func (e Edge) Other(v int) (int, bool) {
+	if v == e.from {
+		return e.to, true
+	}
+	if v == e.to {
+		return e.from, true
+	}
+	return -1, false
+}
+
+// Reverse returns the edge with its endpoints swapped.
+func (e Edge) Reverse() Edge {
+	return NewEdge(e.to, e.from, e.weight)
+}
+
+func (e Edge) String() string {
+	return fmt.Sprintf("%d-%d %.5f", e.from, e.to, e.weight)
+}
@@ -0,0 +1,43 @@
+package graphs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewEdge(t *testing.T) {
+	e := NewEdge(4, 5, 0.35)
+	assert.Equal(t, 4, e.From())
+	assert.Equal(t, 5, e.To())
+	assert.Equal(t, 0.35, e.Weight())
+	assert.Equal(t, e, e.Get().(Edge))
+}
+
+func TestEdge_Other(t *testing.T) {
+	e := NewEdge(4, 5, 0.35)
+	v := e.Either()
+	other, ok := e.Other(v)
+	assert.True(t, ok)
+	assert.Equal(t, 5, other)
+	other, ok = e.Other(other)
+	assert.True(t, ok)
+	assert.Equal(t, 4, other)
+	_, ok = e.Other(6)
+	assert.False(t, ok)
+
+	// Self-loop.
+	other, ok = NewEdge(3, 3, 1.0).Other(3)
+	assert.True(t, ok)
+	assert.Equal(t, 3, other)
+}
+
+func TestEdge_Reverse(t *testing.T) {
+	e := NewEdge(4, 5, 0.35).Reverse()
+	assert.Equal(t, 5, e.From())
+	assert.Equal(t, 4, e.To())
+	assert.Equal(t, 0.35, e.Weight())
+}
+
+func TestEdge_String(t *testing.T) {
+	assert.Equal(t, "4-5 0.35000", NewEdge(4, 5, 0.35).String())
+}
@@ -0,0 +1,82 @@
+package flow
+
+import "math"
+
+// Dinic computes the maximum flow from s to t using Dinic's algorithm.
+// Any flow previously present in the network is discarded.
+// Returns error if s and t are not two distinct vertices.
+//
+// In every phase, a bfs assigns each vertex its distance from s in the residual network.
+// Flow is then only pushed along edges that go from one level to the next, until no such path
+// from s to t remains, i.e., the flow is blocking. Every phase increases the distance from s to t,
+// so there are at most V phases.
+func Dinic(fn *FlowNetwork, s, t int) (*MaxFlow, error) {
+	if err := fn.validateSourceAndSink(s, t); err != nil {
+		return nil, err
+	}
+
+	fn.resetFlow()
+	var level = make([]int, fn.GetV())
+	var adjEdges = make([][]*FlowEdge, fn.GetV())
+	for v := range adjEdges {
+		adjEdges[v], _ = fn.AdjacentEdges(v)
+	}
+	// next[v] is the index of the next edge of v to try in the current phase.
+	// Edges before it cannot lead to t anymore.
+	var next = make([]int, fn.GetV())
+	for fn.residualBfs(s, nil, level); level[t] != -1; fn.residualBfs(s, nil, level) {
+		for v := range next {
+			next[v] = 0
+		}
+		for pushed := dinicDfs(s, t, adjEdges, level, next); pushed > 0; {
+			pushed = dinicDfs(s, t, adjEdges, level, next)
+		}
+	}
+	return fn.newMaxFlow(s), nil
+}
+
+// dinicDfs pushes flow from s to t along a path of edges between consecutive levels.
+// Returns the amount of flow pushed, which is the smallest residual capacity along the path.
+//
+// path holds the vertices on the path being explored. For every v on the path, other than t,
+// adjEdges[v][next[v]] is the edge to the following vertex. The path is kept in a slice rather than
+// on the call stack, as there can be as many levels as vertices.
+func dinicDfs(s, t int, adjEdges [][]*FlowEdge, level, next []int) float64 {
+	var path = []int{s}
+	for len(path) > 0 {
+		v := path[len(path)-1]
+		if v == t {
+			var pushed = math.Inf(1)
+			for _, u := range path[:len(path)-1] {
+				e := adjEdges[u][next[u]]
+				w, _ := e.Other(u)
+				pushed = math.Min(pushed, e.ResidualCapacityTo(w))
+			}
+			for _, u := range path[:len(path)-1] {
+				e := adjEdges[u][next[u]]
+				w, _ := e.Other(u)
+				e.addResidualFlowTo(w, pushed)
+			}
+			return pushed
+		}
+
+		for ; next[v] < len(adjEdges[v]); next[v]++ {
+			e := adjEdges[v][next[v]]
+			w, _ := e.Other(v)
+			if (level[w] == level[v]+1) && (e.ResidualCapacityTo(w) > 0) {
+				break
+			}
+		}
+		if next[v] < len(adjEdges[v]) {
+			w, _ := adjEdges[v][next[v]].Other(v)
+			path = append(path, w)
+			continue
+		}
+		// t cannot be reached from v anymore, so neither can it be using the edge to v.
+		path = path[:len(path)-1]
+		if len(path) > 0 {
+			next[path[len(path)-1]]++
+		}
+	}
+	return 0
+}
@@ -0,0 +1,39 @@
+package flow
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestDinic(t *testing.T) {
+	testMaxFlowTinyFN(t, Dinic)
+}
+
+func TestDinic_AgreesWithEdmondsKarp(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		fn := NewFlowNetwork(12)
+		for j := 0; j < 40; j++ {
+			fn.AddEdge(r.Intn(12), r.Intn(12), float64(r.Intn(10)))
+		}
+		ek, err := EdmondsKarp(fn, 0, 11)
+		assert.NoError(t, err)
+		testMaxFlow(t, fn, 0, 11, ek)
+		dinic, err := Dinic(fn, 0, 11)
+		assert.NoError(t, err)
+		testMaxFlow(t, fn, 0, 11, dinic)
+		assert.InDelta(t, ek.Value(), dinic.Value(), 1e-9)
+	}
+}
+
+func TestDinic_LongPath(t *testing.T) {
+	const n = 200000
+	fn := NewFlowNetwork(n)
+	for v := 0; v+1 < n; v++ {
+		fn.AddEdge(v, v+1, float64(1+v%7))
+	}
+	mf, err := Dinic(fn, 0, n-1)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.0, mf.Value(), 1e-9)
+}
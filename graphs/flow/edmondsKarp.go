@@ -0,0 +1,32 @@
+package flow
+
+import "math"
+
+// EdmondsKarp computes the maximum flow from s to t using the Ford-Fulkerson method.
+// Algorithm taken from https://algs4.cs.princeton.edu/64maxflow/.
+// Any flow previously present in the network is discarded.
+// Returns error if s and t are not two distinct vertices.
+//
+// Flow is repeatedly pushed along a path from s to t in the residual network, which is found using
+// a bfs so that the shortest such path is used. The flow is maximum once t is no longer reachable.
+func EdmondsKarp(fn *FlowNetwork, s, t int) (*MaxFlow, error) {
+	if err := fn.validateSourceAndSink(s, t); err != nil {
+		return nil, err
+	}
+
+	fn.resetFlow()
+	var edgeTo = make([]*FlowEdge, fn.GetV())
+	var level = make([]int, fn.GetV())
+	for fn.residualBfs(s, edgeTo, level); level[t] != -1; fn.residualBfs(s, edgeTo, level) {
+		// Finding the bottleneck capacity of the path.
+		var bottleneck = math.Inf(1)
+		for v := t; v != s; v, _ = edgeTo[v].Other(v) {
+			bottleneck = math.Min(bottleneck, edgeTo[v].ResidualCapacityTo(v))
+		}
+		// Augmenting the flow along the path.
+		for v := t; v != s; v, _ = edgeTo[v].Other(v) {
+			edgeTo[v].addResidualFlowTo(v, bottleneck)
+		}
+	}
+	return fn.newMaxFlow(s), nil
+}
@@ -0,0 +1,71 @@
+package flow
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// maxFlowFunc computes the maximum flow from s to t.
+type maxFlowFunc func(*FlowNetwork, int, int) (*MaxFlow, error)
+
+// testMaxFlow checks that the flow is feasible and that its value equals the capacity of the cut.
+func testMaxFlow(t *testing.T, fn *FlowNetwork, s, sink int, mf *MaxFlow) {
+	var excess = make([]float64, fn.GetV())
+	var cutCapacity = 0.0
+	assert.Len(t, mf.Edges(), fn.GetE())
+	for _, e := range mf.Edges() {
+		assert.True(t, e.Flow() >= 0)
+		assert.True(t, e.Flow() <= e.Capacity())
+		excess[e.To()] += e.Flow()
+		excess[e.From()] -= e.Flow()
+		if mf.InCut(e.From()) && !mf.InCut(e.To()) {
+			cutCapacity += e.Capacity()
+			// Edges across the cut are saturated.
+			assert.InDelta(t, e.Capacity(), e.Flow(), 1e-9)
+		}
+	}
+	for v := 0; v < fn.GetV(); v++ {
+		if (v != s) && (v != sink) {
+			assert.InDelta(t, 0, excess[v], 1e-9)
+		}
+	}
+	assert.InDelta(t, mf.Value(), -excess[s], 1e-9)
+	assert.InDelta(t, mf.Value(), excess[sink], 1e-9)
+	assert.InDelta(t, mf.Value(), cutCapacity, 1e-9)
+	assert.True(t, mf.InCut(s))
+	assert.False(t, mf.InCut(sink))
+}
+
+func testMaxFlowTinyFN(t *testing.T, maxFlow maxFlowFunc) {
+	fn := getFlowNetwork(t)
+	mf, err := maxFlow(fn, 0, 5)
+	assert.NoError(t, err)
+	assert.InDelta(t, 4.0, mf.Value(), 1e-9)
+	testMaxFlow(t, fn, 0, 5, mf)
+	sourceSide, sinkSide := mf.MinCut()
+	assert.Equal(t, []int{0, 2}, sourceSide)
+	assert.Equal(t, []int{1, 3, 4, 5}, sinkSide)
+
+	// Running again discards the previous flow.
+	mf, err = maxFlow(fn, 0, 5)
+	assert.NoError(t, err)
+	assert.InDelta(t, 4.0, mf.Value(), 1e-9)
+
+	// The sink is not reachable.
+	mf, err = maxFlow(fn, 5, 0)
+	assert.NoError(t, err)
+	assert.Zero(t, mf.Value())
+	sourceSide, _ = mf.MinCut()
+	assert.Equal(t, []int{5}, sourceSide)
+
+	_, err = maxFlow(fn, 0, 0)
+	assert.Error(t, err)
+	_, err = maxFlow(fn, 0, 6)
+	assert.Error(t, err)
+	_, err = maxFlow(fn, -1, 5)
+	assert.Error(t, err)
+}
+
+func TestEdmondsKarp(t *testing.T) {
+	testMaxFlowTinyFN(t, EdmondsKarp)
+}
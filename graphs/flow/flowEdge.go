@@ -0,0 +1,84 @@
+package flow
+
+import "fmt"
+
+// FlowEdge is a directed edge in a flow network with a capacity and the flow through it.
+// Implements util.Value so that it can be stored in the other data structures.
+//
+// The edge is present in the adjacency lists of both its endpoints, as flow can be pushed
+// back against the direction of the edge by reducing the flow through it.
+type FlowEdge struct {
+	from     int
+	to       int
+	capacity float64
+	flow     float64
+}
+
+// NewFlowEdge returns an edge from one vertex to another with the given capacity and no flow.
+func NewFlowEdge(from, to int, capacity float64) *FlowEdge {
+	return &FlowEdge{
+		from:     from,
+		to:       to,
+		capacity: capacity,
+		flow:     0,
+	}
+}
+
+func (e *FlowEdge) Get() interface{} {
+	return e
+}
+
+// From returns the vertex at the tail of the edge.
+func (e FlowEdge) From() int {
+	return e.from
+}
+
+// To returns the vertex at the head of the edge.
+func (e FlowEdge) To() int {
+	return e.to
+}
+
+// Capacity returns the capacity of the edge.
+func (e FlowEdge) Capacity() float64 {
+	return e.capacity
+}
+
+// Flow returns the flow through the edge.
+func (e FlowEdge) Flow() float64 {
+	return e.flow
+}
+
+// Other returns the endpoint of the edge that is not the provided vertex.
+// Return false if the provided vertex is not an endpoint of the edge.
+func (e FlowEdge) Other(v int) (int, bool) {
+	if v == e.from {
+		return e.to, true
+	}
+	if v == e.to {
+		return e.from, true
+	}
+	return -1, false
+}
+
+// ResidualCapacityTo returns the amount of flow that can be pushed through the edge towards
+// the given vertex. Towards the head, this is the unused capacity. Towards the tail, this
+// is the flow that can be cancelled.
+func (e FlowEdge) ResidualCapacityTo(v int) float64 {
+	if v == e.to {
+		return e.capacity - e.flow
+	}
+	return e.flow
+}
+
+// addResidualFlowTo pushes delta units of flow through the edge towards the given vertex.
+func (e *FlowEdge) addResidualFlowTo(v int, delta float64) {
+	if v == e.to {
+		e.flow += delta
+	} else {
+		e.flow -= delta
+	}
+}
+
+func (e FlowEdge) String() string {
+	return fmt.Sprintf("%d->%d %.5f/%.5f", e.from, e.to, e.flow, e.capacity)
+}
@@ -0,0 +1,113 @@
+package flow
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/pradykaushik/data-structures/linkedlist"
+)
+
+// FlowNetwork is an edge-weighted directed graph where the weight of every edge is its capacity.
+// API taken from https://algs4.cs.princeton.edu/64maxflow/.
+//
+// Every edge is stored in the adjacency lists of both its endpoints.
+type FlowNetwork struct {
+	adj         []*linkedlist.LinkedList // adjacency list of *FlowEdge.
+	numVertices int
+	numEdges    int
+}
+
+// NewFlowNetwork creates a flow network with the provided number of vertices.
+// Note that this flow network will have no edges to begin with.
+func NewFlowNetwork(v int) *FlowNetwork {
+	fn := &FlowNetwork{
+		adj:         make([]*linkedlist.LinkedList, v),
+		numVertices: v,
+		numEdges:    0,
+	}
+
+	for i := 0; i < fn.numVertices; i++ {
+		fn.adj[i] = linkedlist.New()
+	}
+
+	return fn
+}
+
+func (fn FlowNetwork) GetV() int {
+	return fn.numVertices
+}
+
+func (fn FlowNetwork) GetE() int {
+	return fn.numEdges
+}
+
+// isValid returns whether the given vertex exists in the network.
+func (fn FlowNetwork) isValid(v int) bool {
+	return (v >= 0) && (v < len(fn.adj))
+}
+
+// AddEdge adds the edge v1->v2 with the given capacity.
+// Return false if vertex does not exist or if the capacity is negative.
+func (fn *FlowNetwork) AddEdge(v1 int, v2 int, capacity float64) bool {
+	if !fn.isValid(v1) || !fn.isValid(v2) || (capacity < 0) {
+		return false
+	}
+
+	e := NewFlowEdge(v1, v2, capacity)
+	fn.adj[v1].AddToFront(e)
+	if v1 != v2 {
+		fn.adj[v2].AddToFront(e)
+	}
+	fn.numEdges++
+	return true
+}
+
+// AdjacentEdges returns the edges incident on the given vertex, both incoming and outgoing.
+func (fn FlowNetwork) AdjacentEdges(v int) ([]*FlowEdge, bool) {
+	var adjEdges []*FlowEdge
+	if !fn.isValid(v) {
+		return adjEdges, false
+	}
+
+	for _, e := range fn.adj[v].SerializeIntoArray() {
+		adjEdges = append(adjEdges, e.Get().(*FlowEdge))
+	}
+	return adjEdges, true
+}
+
+// Edges returns all the edges in the network.
+func (fn FlowNetwork) Edges() []*FlowEdge {
+	var edges = make([]*FlowEdge, 0, fn.numEdges)
+	for v := range fn.adj {
+		adjEdges, _ := fn.AdjacentEdges(v)
+		for _, e := range adjEdges {
+			// Only picking up the edge from the adjacency list of its tail.
+			if e.From() == v {
+				edges = append(edges, e)
+			}
+		}
+	}
+	return edges
+}
+
+// resetFlow sets the flow through every edge to 0.
+func (fn *FlowNetwork) resetFlow() {
+	for _, e := range fn.Edges() {
+		e.flow = 0
+	}
+}
+
+func (fn FlowNetwork) String() string {
+	var buf = new(bytes.Buffer)
+	for v := range fn.adj {
+		buf.WriteString(fmt.Sprintf("%d => ", v))
+		var outEdges []*FlowEdge
+		adjEdges, _ := fn.AdjacentEdges(v)
+		for _, e := range adjEdges {
+			if e.From() == v {
+				outEdges = append(outEdges, e)
+			}
+		}
+		buf.WriteString(fmt.Sprintf("%v\n", outEdges))
+	}
+	return buf.String()
+}
@@ -0,0 +1,68 @@
+package flow
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// getFlowNetwork returns the network in tinyFN.txt.
+// The edges are taken from https://algs4.cs.princeton.edu/64maxflow/.
+func getFlowNetwork(t *testing.T) *FlowNetwork {
+	fn := NewFlowNetwork(6)
+	assert.NotNil(t, fn)
+	var edges = []FlowEdge{
+		{from: 0, to: 1, capacity: 2.0}, {from: 0, to: 2, capacity: 3.0},
+		{from: 1, to: 3, capacity: 3.0}, {from: 1, to: 4, capacity: 1.0},
+		{from: 2, to: 3, capacity: 1.0}, {from: 2, to: 4, capacity: 1.0},
+		{from: 3, to: 5, capacity: 2.0}, {from: 4, to: 5, capacity: 3.0},
+	}
+	for _, e := range edges {
+		assert.True(t, fn.AddEdge(e.from, e.to, e.capacity))
+	}
+	return fn
+}
+
+func TestNewFlowNetwork(t *testing.T) {
+	fn := NewFlowNetwork(6)
+	assert.Equal(t, 6, fn.GetV())
+	assert.Equal(t, 0, fn.GetE())
+	assert.Empty(t, fn.Edges())
+}
+
+func TestFlowNetwork_AddEdge(t *testing.T) {
+	fn := getFlowNetwork(t)
+	assert.Equal(t, 8, fn.GetE())
+	assert.Len(t, fn.Edges(), 8)
+	assert.False(t, fn.AddEdge(6, 0, 1.0))
+	assert.False(t, fn.AddEdge(0, 1, -1.0))
+	assert.Equal(t, 8, fn.GetE())
+
+	// Every edge is incident on both its endpoints.
+	adjEdges, validVertex := fn.AdjacentEdges(1)
+	assert.True(t, validVertex)
+	assert.Len(t, adjEdges, 3)
+	_, validVertex = fn.AdjacentEdges(6)
+	assert.False(t, validVertex)
+}
+
+func TestFlowEdge(t *testing.T) {
+	e := NewFlowEdge(0, 1, 2.0)
+	assert.Equal(t, 2.0, e.ResidualCapacityTo(1))
+	assert.Equal(t, 0.0, e.ResidualCapacityTo(0))
+
+	e.addResidualFlowTo(1, 1.5)
+	assert.Equal(t, 1.5, e.Flow())
+	assert.Equal(t, 0.5, e.ResidualCapacityTo(1))
+	assert.Equal(t, 1.5, e.ResidualCapacityTo(0))
+
+	// Cancelling flow.
+	e.addResidualFlowTo(0, 1.0)
+	assert.Equal(t, 0.5, e.Flow())
+
+	other, ok := e.Other(0)
+	assert.True(t, ok)
+	assert.Equal(t, 1, other)
+	_, ok = e.Other(2)
+	assert.False(t, ok)
+	assert.Equal(t, "0->1 0.50000/2.00000", e.String())
+}
@@ -0,0 +1,119 @@
+package flow
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/queue/fifo"
+)
+
+// vertex implements util.Value and represents a vertex stored in a queue.
+type vertex int
+
+func (v vertex) Get() interface{} {
+	return int(v)
+}
+
+// MaxFlow is the result of a maximum flow computation from a source s to a sink t.
+//
+// inCut[v] records whether v is reachable from s in the residual network once the flow is maximum.
+// These vertices form the s side of a minimum cut, whose capacity equals the value of the flow.
+type MaxFlow struct {
+	value float64
+	edges []FlowEdge
+	inCut []bool
+}
+
+// Value returns the value of the maximum flow, i.e., the net flow out of the source.
+func (mf MaxFlow) Value() float64 {
+	return mf.value
+}
+
+// Edges returns every edge of the network along with the flow through it.
+func (mf MaxFlow) Edges() []FlowEdge {
+	return mf.edges
+}
+
+// InCut returns whether the given vertex is on the source side of the minimum cut.
+func (mf MaxFlow) InCut(v int) bool {
+	return (v >= 0) && (v < len(mf.inCut)) && mf.inCut[v]
+}
+
+// MinCut returns the vertices on the source side and on the sink side of the minimum cut.
+func (mf MaxFlow) MinCut() ([]int, []int) {
+	var sourceSide = make([]int, 0, 0)
+	var sinkSide = make([]int, 0, 0)
+	for v, inCut := range mf.inCut {
+		if inCut {
+			sourceSide = append(sourceSide, v)
+		} else {
+			sinkSide = append(sinkSide, v)
+		}
+	}
+	return sourceSide, sinkSide
+}
+
+// validateSourceAndSink returns error if the source and the sink are not two distinct vertices.
+func (fn FlowNetwork) validateSourceAndSink(s, t int) error {
+	if !fn.isValid(s) {
+		return errors.Errorf("source vertex %d does not exist", s)
+	}
+	if !fn.isValid(t) {
+		return errors.Errorf("sink vertex %d does not exist", t)
+	}
+	if s == t {
+		return errors.New("source and sink are the same vertex")
+	}
+	return nil
+}
+
+// residualBfs runs a bfs from s in the residual network, i.e., only following edges through which
+// more flow can be pushed. edgeTo[v] is set to the edge used to reach v and level[v] to the number of
+// edges used, or -1 if v is not reachable.
+func (fn FlowNetwork) residualBfs(s int, edgeTo []*FlowEdge, level []int) {
+	for v := range level {
+		level[v] = -1
+	}
+	// Every vertex is queued at most once.
+	var next = fifo.NewLinearQueueArr(len(fn.adj))
+	next.Enqueue(vertex(s))
+	level[s] = 0
+	for !next.IsEmpty() {
+		nextV, _ := next.Dequeue()
+		v := nextV.Get().(int)
+		adjEdges, _ := fn.AdjacentEdges(v)
+		for _, e := range adjEdges {
+			w, _ := e.Other(v)
+			if (level[w] == -1) && (e.ResidualCapacityTo(w) > 0) {
+				level[w] = level[v] + 1
+				if edgeTo != nil {
+					edgeTo[w] = e
+				}
+				next.Enqueue(vertex(w))
+			}
+		}
+	}
+}
+
+// newMaxFlow records the result once the flow through the network is maximum.
+func (fn FlowNetwork) newMaxFlow(s int) *MaxFlow {
+	var mf = &MaxFlow{
+		value: 0,
+		edges: make([]FlowEdge, 0, fn.numEdges),
+		inCut: make([]bool, len(fn.adj)),
+	}
+	for _, e := range fn.Edges() {
+		mf.edges = append(mf.edges, *e)
+		if e.From() == s {
+			mf.value += e.Flow()
+		}
+		if e.To() == s {
+			mf.value -= e.Flow()
+		}
+	}
+
+	var level = make([]int, len(fn.adj))
+	fn.residualBfs(s, nil, level)
+	for v := range level {
+		mf.inCut[v] = level[v] != -1
+	}
+	return mf
+}
@@ -0,0 +1,50 @@
+package generate
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+	"math/rand"
+)
+
+// BarabasiAlbert creates a random scale-free graph on n vertices using preferential attachment.
+// The graph starts out as the complete graph on the first m+1 vertices. Each of the remaining
+// vertices is then connected to m distinct existing vertices, each picked with probability
+// proportional to its degree.
+// Returns error if m is not in [1, n).
+//
+// Every edge adds both its vertices to a list, so that a vertex appears in the list as many times
+// as its degree. Picking uniformly from the list is then the same as picking proportional to degree.
+func BarabasiAlbert(n, m int, seed int64, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	if (m < 1) || (m >= n) {
+		return nil, errors.Errorf("number of edges per vertex %d is not in [1, %d)", m, n)
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	g := newGraph(n)
+	var endpoints = make([]int, 0, 2*m*n)
+	for v := 0; v <= m; v++ {
+		for w := v + 1; w <= m; w++ {
+			g.AddEdge(v, w)
+			endpoints = append(endpoints, v, w)
+		}
+	}
+
+	for v := m + 1; v < n; v++ {
+		var targets = make(map[int]struct{})
+		var ordered = make([]int, 0, m)
+		for len(ordered) < m {
+			w := endpoints[r.Intn(len(endpoints))]
+			if _, ok := targets[w]; ok {
+				continue
+			}
+			targets[w] = struct{}{}
+			ordered = append(ordered, w)
+		}
+		// Using the order in which the targets were picked, as ranging over the map is not deterministic.
+		for _, w := range ordered {
+			g.AddEdge(v, w)
+			endpoints = append(endpoints, v, w)
+		}
+	}
+	return g, nil
+}
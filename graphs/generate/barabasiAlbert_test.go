@@ -0,0 +1,36 @@
+package generate
+
+import (
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBarabasiAlbert(t *testing.T) {
+	const n, m = 1000, 3
+	g, err := BarabasiAlbert(n, m, 42, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, n, g.GetV())
+	// m(m+1)/2 edges between the first m+1 vertices, and then m edges for every other vertex.
+	assert.Equal(t, m*(m+1)/2+m*(n-m-1), g.GetE())
+	testSimple(t, g)
+	assert.Len(t, g.FindConnectedComponents(), 1)
+
+	// Preferential attachment results in hubs with a degree much larger than the average of ~2m.
+	var maxDegree = 0
+	for _, d := range degrees(g) {
+		assert.True(t, d >= m)
+		if d > maxDegree {
+			maxDegree = d
+		}
+	}
+	assert.True(t, maxDegree > 10*m)
+
+	same, _ := BarabasiAlbert(n, m, 42, undirected.NewUndirectedGraph)
+	assert.Equal(t, g.String(), same.String())
+
+	_, err = BarabasiAlbert(5, 0, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+	_, err = BarabasiAlbert(5, 5, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+}
@@ -0,0 +1,102 @@
+package generate
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+	"math/rand"
+)
+
+// validateProbability returns error if p is not a probability.
+func validateProbability(p float64) error {
+	if !(p >= 0 && p <= 1) {
+		return errors.Errorf("probability %v is not in [0, 1]", p)
+	}
+	return nil
+}
+
+// validateNumVertices returns error if n is negative.
+func validateNumVertices(n int) error {
+	if n < 0 {
+		return errors.Errorf("number of vertices %d is negative", n)
+	}
+	return nil
+}
+
+// ErdosRenyi creates a random graph G(n, p) on n vertices, where each of the n(n-1)/2 pairs of
+// vertices is connected with probability p, independently of the other pairs.
+// Returns error if n is negative or p is not in [0, 1].
+func ErdosRenyi(n int, p float64, seed int64, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	if err := validateNumVertices(n); err != nil {
+		return nil, err
+	}
+	if err := validateProbability(p); err != nil {
+		return nil, err
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	g := newGraph(n)
+	for v := 0; v < n; v++ {
+		for w := v + 1; w < n; w++ {
+			if r.Float64() < p {
+				g.AddEdge(v, w)
+			}
+		}
+	}
+	return g, nil
+}
+
+// ErdosRenyiM creates a random graph G(n, m) on n vertices, with m edges chosen uniformly at
+// random from the n(n-1)/2 pairs of vertices.
+// Returns error if n is negative, or m is negative or more than the number of pairs.
+//
+// The pairs are picked at random, retrying the pairs that were already picked. When more than half
+// of the pairs are to be connected, the pairs that are left out are picked instead, so that the
+// number of retries stays small.
+func ErdosRenyiM(n, m int, seed int64, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	if err := validateNumVertices(n); err != nil {
+		return nil, err
+	}
+	var numPairs = n * (n - 1) / 2
+	if (m < 0) || (m > numPairs) {
+		return nil, errors.Errorf("number of edges %d is not in [0, %d]", m, numPairs)
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	var leaveOut = 2*m > numPairs
+	var numPicks = m
+	if leaveOut {
+		numPicks = numPairs - m
+	}
+	var picked = make(map[[2]int]struct{})
+	var pairs = make([][2]int, 0, numPicks)
+	for len(pairs) < numPicks {
+		v, w := r.Intn(n), r.Intn(n)
+		if v == w {
+			continue
+		}
+		if v > w {
+			v, w = w, v
+		}
+		if _, ok := picked[[2]int{v, w}]; ok {
+			continue
+		}
+		picked[[2]int{v, w}] = struct{}{}
+		pairs = append(pairs, [2]int{v, w})
+	}
+
+	g := newGraph(n)
+	if !leaveOut {
+		for _, pair := range pairs {
+			g.AddEdge(pair[0], pair[1])
+		}
+		return g, nil
+	}
+	for v := 0; v < n; v++ {
+		for w := v + 1; w < n; w++ {
+			if _, ok := picked[[2]int{v, w}]; !ok {
+				g.AddEdge(v, w)
+			}
+		}
+	}
+	return g, nil
+}
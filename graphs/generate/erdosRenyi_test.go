@@ -0,0 +1,54 @@
+package generate
+
+import (
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestErdosRenyi(t *testing.T) {
+	g, err := ErdosRenyi(200, 0.1, 42, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, g.GetV())
+	testSimple(t, g)
+	// The expected number of edges is 0.1 * 19900 = 1990, with a standard deviation of about 42.
+	assert.InDelta(t, 1990, g.GetE(), 200)
+
+	// Seeded.
+	same, _ := ErdosRenyi(200, 0.1, 42, undirected.NewUndirectedGraph)
+	assert.Equal(t, g.String(), same.String())
+	other, _ := ErdosRenyi(200, 0.1, 43, undirected.NewUndirectedGraph)
+	assert.NotEqual(t, g.String(), other.String())
+
+	g, err = ErdosRenyi(10, 0, 42, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, g.GetE())
+	g, err = ErdosRenyi(10, 1, 42, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 45, g.GetE())
+
+	_, err = ErdosRenyi(-1, 0.5, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+	_, err = ErdosRenyi(10, 1.5, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+}
+
+func TestErdosRenyiM(t *testing.T) {
+	// Fewer and more than half of the 45 pairs.
+	for _, m := range []int{0, 10, 30, 45} {
+		g, err := ErdosRenyiM(10, m, 42, undirected.NewUndirectedGraph)
+		assert.NoError(t, err)
+		assert.Equal(t, m, g.GetE())
+		testSimple(t, g)
+
+		same, _ := ErdosRenyiM(10, m, 42, undirected.NewUndirectedGraph)
+		assert.Equal(t, g.String(), same.String())
+	}
+
+	_, err := ErdosRenyiM(10, 46, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+	_, err = ErdosRenyiM(10, -1, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+	_, err = ErdosRenyiM(-1, 0, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+}
@@ -0,0 +1,84 @@
+package generate
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"math/rand"
+)
+
+// RandomTree creates a tree on n vertices picked uniformly at random from all the n^(n-2) labelled
+// trees.
+// Returns error if n is negative.
+//
+// A random Prüfer sequence of length n-2 is decoded into the tree. Each vertex appears in the
+// sequence one less time than its degree. The leaves are connected in increasing order to the next
+// vertex in the sequence, which becomes a leaf once all its occurrences are used up.
+func RandomTree(n int, seed int64, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	if err := validateNumVertices(n); err != nil {
+		return nil, err
+	}
+	g := newGraph(n)
+	if n < 2 {
+		return g, nil
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	var sequence = make([]int, n-2)
+	var degree = make([]int, n)
+	for i := range degree {
+		degree[i] = 1
+	}
+	for i := range sequence {
+		sequence[i] = r.Intn(n)
+		degree[sequence[i]]++
+	}
+
+	// leaf is the smallest leaf. As a vertex that becomes a leaf could be smaller than it, such a
+	// vertex is connected right away instead of being looked for later.
+	var ptr = 0
+	for degree[ptr] != 1 {
+		ptr++
+	}
+	var leaf = ptr
+	for _, v := range sequence {
+		g.AddEdge(leaf, v)
+		degree[v]--
+		if (degree[v] == 1) && (v < ptr) {
+			leaf = v
+			continue
+		}
+		ptr++
+		for degree[ptr] != 1 {
+			ptr++
+		}
+		leaf = ptr
+	}
+	// The last two vertices.
+	g.AddEdge(leaf, n-1)
+	return g, nil
+}
+
+// RandomDAG creates a random directed acyclic graph on n vertices, where the vertices are
+// shuffled into a random order and every vertex is connected to each of the vertices that come
+// after it with probability p.
+// newGraph should create a graphs.Digraph, as the edges are directed from the earlier vertex to the later one.
+// Returns error if n is negative or p is not in [0, 1].
+func RandomDAG(n int, p float64, seed int64, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	if err := validateNumVertices(n); err != nil {
+		return nil, err
+	}
+	if err := validateProbability(p); err != nil {
+		return nil, err
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	var order = r.Perm(n)
+	g := newGraph(n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if r.Float64() < p {
+				g.AddEdge(order[i], order[j])
+			}
+		}
+	}
+	return g, nil
+}
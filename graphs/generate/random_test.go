@@ -0,0 +1,71 @@
+package generate
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/directed"
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newDirectedGraph(v int) graphs.Graph {
+	return directed.NewDirectedGraph(v)
+}
+
+func TestRandomTree(t *testing.T) {
+	for n := 0; n < 30; n++ {
+		for seed := int64(0); seed < 5; seed++ {
+			g, err := RandomTree(n, seed, undirected.NewUndirectedGraph)
+			assert.NoError(t, err)
+			assert.Equal(t, n, g.GetV())
+			if n == 0 {
+				continue
+			}
+			// A connected graph with n-1 edges is a tree.
+			assert.Equal(t, n-1, g.GetE())
+			assert.Len(t, g.FindConnectedComponents(), 1)
+		}
+	}
+
+	g, _ := RandomTree(100, 42, undirected.NewUndirectedGraph)
+	same, _ := RandomTree(100, 42, undirected.NewUndirectedGraph)
+	assert.Equal(t, g.String(), same.String())
+
+	_, err := RandomTree(-1, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+}
+
+func TestRandomTree_Uniform(t *testing.T) {
+	// There are 4^2 = 16 labelled trees on 4 vertices: 4 stars and 12 paths.
+	var stars = 0
+	const samples = 1600
+	for seed := int64(0); seed < samples; seed++ {
+		g, _ := RandomTree(4, seed, undirected.NewUndirectedGraph)
+		for _, d := range degrees(g) {
+			if d == 3 {
+				stars++
+			}
+		}
+	}
+	assert.InDelta(t, samples/4, stars, 60)
+}
+
+func TestRandomDAG(t *testing.T) {
+	g, err := RandomDAG(50, 0.3, 42, newDirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 50, g.GetV())
+	assert.True(t, g.GetE() > 0)
+	assert.False(t, g.(*directed.DirectedGraph).HasCycle())
+	_, err = g.(*directed.DirectedGraph).TopologicalSort()
+	assert.NoError(t, err)
+
+	g, _ = RandomDAG(10, 1, 42, newDirectedGraph)
+	assert.Equal(t, 45, g.GetE())
+	assert.False(t, g.(*directed.DirectedGraph).HasCycle())
+
+	same, _ := RandomDAG(10, 1, 42, newDirectedGraph)
+	assert.Equal(t, g.String(), same.String())
+
+	_, err = RandomDAG(10, 2, 42, newDirectedGraph)
+	assert.Error(t, err)
+}
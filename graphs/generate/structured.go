@@ -0,0 +1,91 @@
+// Package generate creates graphs with a known structure, and random graphs from the commonly
+// used models, for testing the graph algorithms on more than hand-built graphs.
+//
+// Graphs are created using the given constructor, such as undirected.NewUndirectedGraph, and
+// are undirected unless stated otherwise. The random generators take a seed, and always create the
+// same graph for the same seed.
+package generate
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+)
+
+// Complete creates the complete graph on n vertices, where every pair of vertices is connected.
+// Returns error if n is negative.
+func Complete(n int, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	if err := validateNumVertices(n); err != nil {
+		return nil, err
+	}
+	g := newGraph(n)
+	for v := 0; v < n; v++ {
+		for w := v + 1; w < n; w++ {
+			g.AddEdge(v, w)
+		}
+	}
+	return g, nil
+}
+
+// Path creates the path 0-1-...-(n-1).
+// Returns error if n is negative.
+func Path(n int, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	if err := validateNumVertices(n); err != nil {
+		return nil, err
+	}
+	g := newGraph(n)
+	for v := 0; v+1 < n; v++ {
+		g.AddEdge(v, v+1)
+	}
+	return g, nil
+}
+
+// Cycle creates the cycle 0-1-...-(n-1)-0.
+// As a cycle needs at least 3 vertices without self-loops or parallel edges, a path is created
+// for fewer vertices.
+// Returns error if n is negative.
+func Cycle(n int, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	g, err := Path(n, newGraph)
+	if err != nil {
+		return nil, err
+	}
+	if n >= 3 {
+		g.AddEdge(n-1, 0)
+	}
+	return g, nil
+}
+
+// Star creates a star with vertex 0 at the center, connected to each of the other n-1 vertices.
+// Returns error if n is negative.
+func Star(n int, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	if err := validateNumVertices(n); err != nil {
+		return nil, err
+	}
+	g := newGraph(n)
+	for v := 1; v < n; v++ {
+		g.AddEdge(0, v)
+	}
+	return g, nil
+}
+
+// Grid creates a grid with the given number of rows and columns, where every vertex is connected
+// to the vertices to its right and below it.
+// The vertex in row r and column c is numbered r*cols + c.
+// Returns error if either rows or cols is negative.
+func Grid(rows, cols int, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	if (rows < 0) || (cols < 0) {
+		return nil, errors.Errorf("grid of %d rows and %d columns has a negative dimension", rows, cols)
+	}
+	g := newGraph(rows * cols)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			v := r*cols + c
+			if c+1 < cols {
+				g.AddEdge(v, v+1)
+			}
+			if r+1 < rows {
+				g.AddEdge(v, v+cols)
+			}
+		}
+	}
+	return g, nil
+}
@@ -0,0 +1,109 @@
+package generate
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// testSimple checks that the graph has no self-loops or parallel edges.
+func testSimple(t *testing.T, g graphs.Graph) {
+	for v := 0; v < g.GetV(); v++ {
+		var seen = make(map[int]struct{})
+		adjList, _ := g.Adjacent(v)
+		for _, w := range adjList {
+			assert.NotEqual(t, v, w)
+			_, ok := seen[w]
+			assert.False(t, ok, "parallel edge %d-%d", v, w)
+			seen[w] = struct{}{}
+		}
+	}
+}
+
+func degrees(g graphs.Graph) []int {
+	var result = make([]int, g.GetV())
+	for v := range result {
+		result[v], _ = g.Degree(v)
+	}
+	return result
+}
+
+func TestComplete(t *testing.T) {
+	g, err := Complete(6, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, g.GetV())
+	assert.Equal(t, 15, g.GetE())
+	testSimple(t, g)
+	assert.Equal(t, []int{5, 5, 5, 5, 5, 5}, degrees(g))
+	g, err = Complete(0, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, g.GetV())
+
+	_, err = Complete(-1, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+}
+
+func TestPath(t *testing.T) {
+	g, err := Path(5, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, g.GetE())
+	path, found := g.FindPath(0, 4)
+	assert.True(t, found)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, path)
+	g, err = Path(1, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, g.GetE())
+
+	_, err = Path(-1, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+}
+
+func TestCycle(t *testing.T) {
+	g, err := Cycle(5, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, g.GetE())
+	assert.Equal(t, []int{2, 2, 2, 2, 2}, degrees(g))
+	assert.True(t, g.(*undirected.UndirectedGraph).HasCycle())
+
+	g, err = Cycle(2, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, g.GetE())
+	assert.False(t, g.(*undirected.UndirectedGraph).HasCycle())
+
+	_, err = Cycle(-1, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+}
+
+func TestStar(t *testing.T) {
+	g, err := Star(5, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, g.GetE())
+	assert.Equal(t, []int{4, 1, 1, 1, 1}, degrees(g))
+
+	_, err = Star(-1, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+}
+
+func TestGrid(t *testing.T) {
+	g, err := Grid(3, 4, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, g.GetV())
+	// 3 rows of 3 horizontal edges, and 4 columns of 2 vertical edges.
+	assert.Equal(t, 17, g.GetE())
+	testSimple(t, g)
+	assert.Equal(t, []int{2, 3, 3, 2, 3, 4, 4, 3, 2, 3, 3, 2}, degrees(g))
+	assert.True(t, g.HasEdge(5, 6))
+	assert.True(t, g.HasEdge(5, 9))
+	assert.False(t, g.HasEdge(3, 4))
+
+	g, err = Grid(0, 4, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, g.GetV())
+
+	// A negative number of rows and columns would otherwise have a positive number of vertices.
+	_, err = Grid(-3, -4, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+	_, err = Grid(3, -1, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package generate
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+	"math/rand"
+)
+
+// WattsStrogatz creates a random small-world graph on n vertices.
+// The graph starts out as a ring, where every vertex is connected to the k/2 vertices on either
+// side of it. Then each edge v-w, with w following v on the ring, is rewired with probability beta
+// to connect v to a vertex picked uniformly at random instead, avoiding self-loops and parallel edges.
+// Returns error if k is not even and in [0, n), or beta is not in [0, 1].
+func WattsStrogatz(n, k int, beta float64, seed int64, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	if err := validateNumVertices(n); err != nil {
+		return nil, err
+	}
+	if (k < 0) || (k%2 != 0) || ((k > 0) && (k >= n)) {
+		return nil, errors.Errorf("number of neighbours %d is not even and in [0, %d)", k, n)
+	}
+	if err := validateProbability(beta); err != nil {
+		return nil, err
+	}
+
+	var adjacent = make([]map[int]struct{}, n)
+	for v := range adjacent {
+		adjacent[v] = make(map[int]struct{})
+	}
+	var pairs = make([][2]int, 0, n*k/2)
+	for j := 1; j <= k/2; j++ {
+		for v := 0; v < n; v++ {
+			w := (v + j) % n
+			adjacent[v][w] = struct{}{}
+			adjacent[w][v] = struct{}{}
+			pairs = append(pairs, [2]int{v, w})
+		}
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	for i, pair := range pairs {
+		v, w := pair[0], pair[1]
+		// A vertex connected to all the others cannot be rewired.
+		if (r.Float64() >= beta) || (len(adjacent[v]) == n-1) {
+			continue
+		}
+		u := r.Intn(n)
+		for (u == v) || isAdjacent(adjacent, v, u) {
+			u = r.Intn(n)
+		}
+		delete(adjacent[v], w)
+		delete(adjacent[w], v)
+		adjacent[v][u] = struct{}{}
+		adjacent[u][v] = struct{}{}
+		pairs[i][1] = u
+	}
+
+	g := newGraph(n)
+	for _, pair := range pairs {
+		g.AddEdge(pair[0], pair[1])
+	}
+	return g, nil
+}
+
+func isAdjacent(adjacent []map[int]struct{}, v, w int) bool {
+	_, ok := adjacent[v][w]
+	return ok
+}
@@ -0,0 +1,43 @@
+package generate
+
+import (
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWattsStrogatz(t *testing.T) {
+	// No rewiring results in the ring.
+	g, err := WattsStrogatz(10, 4, 0, 42, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, g.GetE())
+	testSimple(t, g)
+	for v := 0; v < 10; v++ {
+		assert.True(t, g.HasEdge(v, (v+1)%10))
+		assert.True(t, g.HasEdge(v, (v+2)%10))
+	}
+
+	for _, beta := range []float64{0.2, 1} {
+		g, err = WattsStrogatz(100, 6, beta, 42, undirected.NewUndirectedGraph)
+		assert.NoError(t, err)
+		// Rewiring keeps the number of edges.
+		assert.Equal(t, 300, g.GetE())
+		testSimple(t, g)
+
+		same, _ := WattsStrogatz(100, 6, beta, 42, undirected.NewUndirectedGraph)
+		assert.Equal(t, g.String(), same.String())
+	}
+
+	// Vertices connected to all the others are not rewired.
+	g, err = WattsStrogatz(5, 4, 1, 42, undirected.NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, g.GetE())
+	testSimple(t, g)
+
+	_, err = WattsStrogatz(10, 3, 0.5, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+	_, err = WattsStrogatz(10, 10, 0.5, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+	_, err = WattsStrogatz(10, 4, -0.5, 42, undirected.NewUndirectedGraph)
+	assert.Error(t, err)
+}
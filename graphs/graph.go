@@ -1,5 +1,7 @@
 package graphs
 
+import "context"
+
 // Graph defines an API for an undirected graph.
 // API taken from https://algs4.cs.princeton.edu/41graph/.
 //
@@ -12,6 +14,19 @@ type Graph interface {
 	// AddEdge adds an edge to connect the two vertices.
 	// Return false if vertex does not exist.
 	AddEdge(int, int) bool
+	// HasEdge returns whether there is an edge connecting the two vertices.
+	HasEdge(int, int) bool
+	// RemoveEdge removes an edge connecting the two vertices. If there are parallel edges,
+	// only one of them is removed.
+	// Return false if there is no such edge.
+	RemoveEdge(int, int) bool
+	// AddVertex adds a vertex with no edges and returns it. The new vertex is numbered V.
+	AddVertex() int
+	// RemoveVertex removes the vertex along with all the edges incident on it.
+	// The vertices numbered above the removed one are renumbered to one less, so that
+	// vertices are still numbered from 0 to V-1.
+	// Return false if vertex does not exist.
+	RemoveVertex(int) bool
 	// Adjacent returns the list of vertices adjacent to the provided one.
 	Adjacent(int) ([]int, bool)
 	// Degree returns the number of edges incident on the given vertex.
@@ -35,3 +50,31 @@ type Graph interface {
 	FindPathV2(int, int) ([]int, bool)
 	FindConnectedComponents() [][]int
 }
+
+// CancellableGraph defines variants of the traversals and graph based algorithms that can be
+// cancelled mid-walk using a context, which is useful for large graphs.
+//
+// Once the context is cancelled or its deadline is exceeded, the walk stops and ctx.Err() is
+// returned along with the result computed so far.
+type CancellableGraph interface {
+	Graph
+	DfsContext(context.Context) ([]int, error)
+	BfsContext(context.Context) ([]int, error)
+	// ConnectedVerticesContext returns error if vertex does not exist.
+	ConnectedVerticesContext(context.Context, int) ([]int, error)
+	// FindPathContext returns error if either vertex does not exist.
+	// Return false if there is no path.
+	FindPathContext(context.Context, int, int) ([]int, bool, error)
+	FindConnectedComponentsContext(context.Context) ([][]int, error)
+}
+
+// Digraph defines an API for a directed graph.
+// API taken from https://algs4.cs.princeton.edu/42digraph/.
+//
+// An edge v->w added using AddEdge(v, w) can only be traversed from v to w.
+// Adjacent returns the vertices pointed to by the provided vertex.
+type Digraph interface {
+	Graph
+	// Reverse returns a copy of the digraph with all the edges reversed.
+	Reverse() Digraph
+}
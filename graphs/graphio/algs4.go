@@ -0,0 +1,163 @@
+package graphio
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+	"io"
+	"strconv"
+)
+
+// tokenReader reads whitespace separated tokens.
+type tokenReader struct {
+	scanner *bufio.Scanner
+}
+
+func newTokenReader(r io.Reader) *tokenReader {
+	var scanner = bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+	return &tokenReader{scanner: scanner}
+}
+
+// next returns the next token. Returns error naming what was expected if there are no more tokens.
+func (tr *tokenReader) next(what string) (string, error) {
+	if !tr.scanner.Scan() {
+		if err := tr.scanner.Err(); err != nil {
+			return "", errors.Wrapf(err, "failed to read %s", what)
+		}
+		return "", errors.Errorf("unexpected end of input, expected %s", what)
+	}
+	return tr.scanner.Text(), nil
+}
+
+func (tr *tokenReader) nextInt(what string) (int, error) {
+	token, err := tr.next(what)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, errors.Errorf("invalid %s %q", what, token)
+	}
+	return n, nil
+}
+
+func (tr *tokenReader) nextFloat(what string) (float64, error) {
+	token, err := tr.next(what)
+	if err != nil {
+		return 0, err
+	}
+	f, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid %s %q", what, token)
+	}
+	return f, nil
+}
+
+// readHeader reads the number of vertices and edges.
+func (tr *tokenReader) readHeader() (int, int, error) {
+	numVertices, err := tr.nextInt("number of vertices")
+	if err != nil {
+		return 0, 0, err
+	}
+	if numVertices < 0 {
+		return 0, 0, errors.Errorf("number of vertices %d is negative", numVertices)
+	}
+	numEdges, err := tr.nextInt("number of edges")
+	if err != nil {
+		return 0, 0, err
+	}
+	if numEdges < 0 {
+		return 0, 0, errors.Errorf("number of edges %d is negative", numEdges)
+	}
+	return numVertices, numEdges, nil
+}
+
+// ReadAlgs4 reads a graph in the format of tinyG.txt from https://algs4.cs.princeton.edu/41graph/.
+// The input holds the number of vertices V, the number of edges E, and then E pairs of vertices,
+// all separated by whitespace. The graph is created using newGraph(V).
+// Returns error if the input is malformed, if an edge has a vertex that does not exist, or if the
+// graph does not accept an edge.
+func ReadAlgs4(r io.Reader, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	var tr = newTokenReader(r)
+	numVertices, numEdges, err := tr.readHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	var g = newGraph(numVertices)
+	for i := 0; i < numEdges; i++ {
+		v, err := tr.nextInt(fmt.Sprintf("vertex of edge %d", i))
+		if err != nil {
+			return nil, err
+		}
+		w, err := tr.nextInt(fmt.Sprintf("vertex of edge %d", i))
+		if err != nil {
+			return nil, err
+		}
+		if err := addEdge(g, v, w); err != nil {
+			return nil, errors.Wrapf(err, "edge %d", i)
+		}
+	}
+	return g, nil
+}
+
+// WriteAlgs4 writes the graph in the format read by ReadAlgs4.
+// Every edge is written once, on a line of its own.
+func WriteAlgs4(w io.Writer, g graphs.Graph) error {
+	var pairs = edges(g)
+	var bw = bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%d\n%d\n", g.GetV(), len(pairs))
+	for _, p := range pairs {
+		fmt.Fprintf(bw, "%d %d\n", p[0], p[1])
+	}
+	return errors.Wrap(bw.Flush(), "failed to write graph")
+}
+
+// ReadWeightedAlgs4 reads an edge-weighted graph in the format of tinyEWG.txt and tinyEWD.txt
+// from https://algs4.cs.princeton.edu/43mst/. The format is the same as the one read by ReadAlgs4,
+// except that every edge is followed by its weight. The graph is created using newGraph(V).
+// Returns error if the input is malformed, if an edge has a vertex that does not exist, or if the
+// graph does not accept an edge.
+func ReadWeightedAlgs4(r io.Reader, newGraph func(int) graphs.WeightedGraph) (graphs.WeightedGraph, error) {
+	var tr = newTokenReader(r)
+	numVertices, numEdges, err := tr.readHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	var g = newGraph(numVertices)
+	for i := 0; i < numEdges; i++ {
+		v, err := tr.nextInt(fmt.Sprintf("vertex of edge %d", i))
+		if err != nil {
+			return nil, err
+		}
+		w, err := tr.nextInt(fmt.Sprintf("vertex of edge %d", i))
+		if err != nil {
+			return nil, err
+		}
+		weight, err := tr.nextFloat(fmt.Sprintf("weight of edge %d", i))
+		if err != nil {
+			return nil, err
+		}
+		if err := validateEdge(g.GetV(), v, w); err != nil {
+			return nil, errors.Wrapf(err, "edge %d", i)
+		}
+		if !g.AddWeightedEdge(v, w, weight) {
+			return nil, errors.Errorf("edge %d: %d-%d is not accepted by the graph", i, v, w)
+		}
+	}
+	return g, nil
+}
+
+// WriteWeightedAlgs4 writes the edge-weighted graph in the format read by ReadWeightedAlgs4.
+func WriteWeightedAlgs4(w io.Writer, g graphs.WeightedGraph) error {
+	var weightedEdges = g.Edges()
+	var bw = bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%d\n%d\n", g.GetV(), len(weightedEdges))
+	for _, e := range weightedEdges {
+		fmt.Fprintf(bw, "%d %d %s\n", e.From(), e.To(), strconv.FormatFloat(e.Weight(), 'g', -1, 64))
+	}
+	return errors.Wrap(bw.Flush(), "failed to write graph")
+}
@@ -0,0 +1,162 @@
+package graphio
+
+import (
+	"bytes"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/directed"
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+// tinyG is tinyG.txt from https://algs4.cs.princeton.edu/41graph/.
+const tinyG = `13
+13
+0 5
+4 3
+0 1
+9 12
+6 4
+5 4
+0 2
+11 12
+9 10
+0 6
+7 8
+9 11
+5 3
+`
+
+// tinyEWG is tinyEWG.txt from https://algs4.cs.princeton.edu/43mst/.
+const tinyEWG = `8
+16
+4 5 0.35
+4 7 0.37
+5 7 0.28
+0 7 0.16
+1 5 0.32
+0 4 0.38
+2 3 0.17
+1 7 0.19
+0 2 0.26
+1 2 0.36
+1 3 0.29
+2 7 0.34
+6 2 0.40
+3 6 0.52
+6 0 0.58
+6 4 0.93
+`
+
+func newUndirectedGraph(v int) graphs.Graph {
+	return undirected.NewUndirectedGraph(v)
+}
+
+func newDirectedGraph(v int) graphs.Graph {
+	return directed.NewDirectedGraph(v)
+}
+
+// testSameGraph checks that the two graphs have the same vertices and the same edges,
+// in any order.
+func testSameGraph(t *testing.T, expected, actual graphs.Graph) {
+	assert.Equal(t, expected.GetV(), actual.GetV())
+	for v := 0; v < expected.GetV(); v++ {
+		expectedAdj, _ := expected.Adjacent(v)
+		actualAdj, _ := actual.Adjacent(v)
+		assert.ElementsMatch(t, expectedAdj, actualAdj)
+	}
+}
+
+func TestReadAlgs4(t *testing.T) {
+	g, err := ReadAlgs4(strings.NewReader(tinyG), newUndirectedGraph)
+	assert.NoError(t, err)
+	var expectedAdjLists = [][]int{
+		{6, 2, 1, 5}, {0}, {0}, {5, 4}, {5, 6, 3}, {3, 4, 0}, {0, 4},
+		{8}, {7}, {11, 10, 12}, {9}, {9, 12}, {11, 9},
+	}
+	assert.Equal(t, 13, g.GetV())
+	for v, expected := range expectedAdjLists {
+		adjList, _ := g.Adjacent(v)
+		assert.Equal(t, expected, adjList)
+	}
+
+	dg, err := ReadAlgs4(strings.NewReader(tinyG), newDirectedGraph)
+	assert.NoError(t, err)
+	_, ok := dg.(graphs.Digraph)
+	assert.True(t, ok)
+	assert.Equal(t, 13, dg.GetE())
+	adjList, _ := dg.Adjacent(0)
+	assert.Equal(t, []int{6, 2, 1, 5}, adjList)
+	adjList, _ = dg.Adjacent(5)
+	assert.Equal(t, []int{3, 4}, adjList)
+}
+
+func TestReadAlgs4_Errors(t *testing.T) {
+	var inputs = []string{
+		"",
+		"3",
+		"x 1",
+		"-1 0",
+		"3 -1",
+		"3 2\n0 1\n",
+		"3 1\n0 y\n",
+		"3 1\n0 3\n",
+		"3 1\n-1 0\n",
+	}
+	for _, input := range inputs {
+		_, err := ReadAlgs4(strings.NewReader(input), newUndirectedGraph)
+		assert.Error(t, err, "input %q", input)
+	}
+
+	_, err := ReadAlgs4(strings.NewReader("3 1\n0 3\n"), newUndirectedGraph)
+	assert.Contains(t, err.Error(), "vertex 3 that does not exist")
+	// A parallel edge cannot be represented in an adjacency matrix.
+	_, err = ReadAlgs4(strings.NewReader("3 2\n0 1\n1 0\n"), undirected.NewAdjacencyMatrixGraph)
+	assert.EqualError(t, err, "edge 1: 1-0 is not accepted by the graph")
+}
+
+func TestWriteAlgs4(t *testing.T) {
+	for _, newGraph := range []func(int) graphs.Graph{newUndirectedGraph, newDirectedGraph} {
+		g, err := ReadAlgs4(strings.NewReader(tinyG), newGraph)
+		assert.NoError(t, err)
+		// Adding a self-loop and a parallel edge, which must also be written once each.
+		g.AddEdge(2, 2)
+		g.AddEdge(0, 1)
+
+		var buf = new(bytes.Buffer)
+		assert.NoError(t, WriteAlgs4(buf, g))
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Equal(t, "13", lines[0])
+		assert.Equal(t, "15", lines[1])
+		assert.Len(t, lines, 17)
+
+		readBack, err := ReadAlgs4(buf, newGraph)
+		assert.NoError(t, err)
+		testSameGraph(t, g, readBack)
+	}
+}
+
+func TestReadWeightedAlgs4(t *testing.T) {
+	var newGraph = func(v int) graphs.WeightedGraph {
+		return undirected.NewWeightedUndirectedGraph(v)
+	}
+	g, err := ReadWeightedAlgs4(strings.NewReader(tinyEWG), newGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, g.GetV())
+	assert.Len(t, g.Edges(), 16)
+	edges, _ := g.AdjacentEdges(6)
+	assert.Len(t, edges, 4)
+
+	var buf = new(bytes.Buffer)
+	assert.NoError(t, WriteWeightedAlgs4(buf, g))
+	readBack, err := ReadWeightedAlgs4(buf, newGraph)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, g.Edges(), readBack.Edges())
+	assert.InDelta(t, g.TotalWeight(), readBack.TotalWeight(), 1e-9)
+
+	_, err = ReadWeightedAlgs4(strings.NewReader("2 1\n0 1\n"), newGraph)
+	assert.Error(t, err)
+	_, err = ReadWeightedAlgs4(strings.NewReader("2 1\n0 1 heavy\n"), newGraph)
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package graphio
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+	"io"
+)
+
+// DOTOptions configures the output of WriteDOT. The zero value writes the graph without highlighting.
+type DOTOptions struct {
+	// Name of the graph. Defaults to G.
+	Name string
+	// Path is a sequence of vertices, such as one returned by FindPath. The vertices on the path and
+	// the edges between consecutive vertices are highlighted.
+	Path []int
+	// Component is a set of vertices, such as one returned by FindConnectedComponents. The vertices
+	// in the component and the edges between them are highlighted.
+	Component []int
+	// Color used for highlighting. Defaults to red.
+	Color string
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language.
+// A Digraph is written as a digraph with directed edges, and any other graph as an undirected graph.
+// Every vertex is written, so that vertices without edges are shown as well.
+func WriteDOT(w io.Writer, g graphs.Graph, opts DOTOptions) error {
+	var name = opts.Name
+	if name == "" {
+		name = "G"
+	}
+	var color = opts.Color
+	if color == "" {
+		color = "red"
+	}
+	_, directed := g.(graphs.Digraph)
+	var graphType, edgeOp = "graph", "--"
+	if directed {
+		graphType, edgeOp = "digraph", "->"
+	}
+
+	var highlightedVertices = make(map[int]struct{})
+	var highlightedEdges = make(map[[2]int]struct{})
+	var highlightEdge = func(v, w int) {
+		highlightedEdges[[2]int{v, w}] = struct{}{}
+		if !directed {
+			highlightedEdges[[2]int{w, v}] = struct{}{}
+		}
+	}
+	for i, v := range opts.Path {
+		highlightedVertices[v] = struct{}{}
+		if i > 0 {
+			highlightEdge(opts.Path[i-1], v)
+		}
+	}
+	var inComponent = make(map[int]struct{})
+	for _, v := range opts.Component {
+		highlightedVertices[v] = struct{}{}
+		inComponent[v] = struct{}{}
+	}
+	var pairs = edges(g)
+	for _, p := range pairs {
+		_, fromIn := inComponent[p[0]]
+		_, toIn := inComponent[p[1]]
+		if fromIn && toIn {
+			highlightEdge(p[0], p[1])
+		}
+	}
+
+	var bw = bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%s %q {\n", graphType, name)
+	for v := 0; v < g.GetV(); v++ {
+		if _, ok := highlightedVertices[v]; ok {
+			fmt.Fprintf(bw, "  %d [color=%q];\n", v, color)
+		} else {
+			fmt.Fprintf(bw, "  %d;\n", v)
+		}
+	}
+	for _, p := range pairs {
+		if _, ok := highlightedEdges[p]; ok {
+			fmt.Fprintf(bw, "  %d %s %d [color=%q, penwidth=2];\n", p[0], edgeOp, p[1], color)
+		} else {
+			fmt.Fprintf(bw, "  %d %s %d;\n", p[0], edgeOp, p[1])
+		}
+	}
+	fmt.Fprintf(bw, "}\n")
+	return errors.Wrap(bw.Flush(), "failed to write graph")
+}
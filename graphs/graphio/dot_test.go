@@ -0,0 +1,77 @@
+package graphio
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWriteDOT(t *testing.T) {
+	g := newUndirectedGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+
+	var buf = new(bytes.Buffer)
+	assert.NoError(t, WriteDOT(buf, g, DOTOptions{}))
+	assert.Equal(t, `graph "G" {
+  0;
+  1;
+  2;
+  3;
+  0 -- 1;
+  0 -- 2;
+  1 -- 2;
+}
+`, buf.String())
+
+	dg := newDirectedGraph(3)
+	dg.AddEdge(0, 1)
+	dg.AddEdge(1, 2)
+	dg.AddEdge(2, 0)
+	buf.Reset()
+	assert.NoError(t, WriteDOT(buf, dg, DOTOptions{Name: "cycle", Path: []int{2, 0, 1}, Color: "blue"}))
+	assert.Equal(t, `digraph "cycle" {
+  0 [color="blue"];
+  1 [color="blue"];
+  2 [color="blue"];
+  0 -> 1 [color="blue", penwidth=2];
+  1 -> 2;
+  2 -> 0 [color="blue", penwidth=2];
+}
+`, buf.String())
+}
+
+func TestWriteDOT_Highlight(t *testing.T) {
+	g := newUndirectedGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+
+	// Undirected edges on the path are highlighted in either direction.
+	var buf = new(bytes.Buffer)
+	assert.NoError(t, WriteDOT(buf, g, DOTOptions{Path: []int{2, 1}}))
+	assert.Equal(t, `graph "G" {
+  0;
+  1 [color="red"];
+  2 [color="red"];
+  3;
+  0 -- 1;
+  1 -- 2 [color="red", penwidth=2];
+  2 -- 3;
+}
+`, buf.String())
+
+	buf.Reset()
+	assert.NoError(t, WriteDOT(buf, g, DOTOptions{Component: []int{0, 1, 3}}))
+	assert.Equal(t, `graph "G" {
+  0 [color="red"];
+  1 [color="red"];
+  2;
+  3 [color="red"];
+  0 -- 1 [color="red", penwidth=2];
+  1 -- 2;
+  2 -- 3;
+}
+`, buf.String())
+}
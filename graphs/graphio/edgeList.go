@@ -0,0 +1,67 @@
+package graphio
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadEdgeList reads a graph from a list of edges, with one edge per line given as two vertices
+// separated by whitespace. Empty lines and lines starting with '#' are ignored.
+// The number of vertices is one more than the largest vertex, and the graph is created using
+// newGraph. Vertices that are larger than all the vertices with edges are therefore not preserved.
+// Returns error if a line does not hold exactly two non-negative integers, or if the graph does
+// not accept an edge.
+func ReadEdgeList(r io.Reader, newGraph func(int) graphs.Graph) (graphs.Graph, error) {
+	var pairs = make([][2]int, 0, 0)
+	var lineNums = make([]int, 0, 0) // line of each of the pairs.
+	var numVertices = 0
+	var scanner = bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if (line == "") || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, errors.Errorf("line %d: expected 2 vertices, found %q", lineNum, line)
+		}
+		var pair [2]int
+		for i, field := range fields {
+			v, err := strconv.Atoi(field)
+			if (err != nil) || (v < 0) {
+				return nil, errors.Errorf("line %d: invalid vertex %q", lineNum, field)
+			}
+			pair[i] = v
+			if v >= numVertices {
+				numVertices = v + 1
+			}
+		}
+		pairs = append(pairs, pair)
+		lineNums = append(lineNums, lineNum)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read edge list")
+	}
+
+	var g = newGraph(numVertices)
+	for i, p := range pairs {
+		if err := addEdge(g, p[0], p[1]); err != nil {
+			return nil, errors.Wrapf(err, "line %d", lineNums[i])
+		}
+	}
+	return g, nil
+}
+
+// WriteEdgeList writes the edges of the graph in the format read by ReadEdgeList.
+func WriteEdgeList(w io.Writer, g graphs.Graph) error {
+	var bw = bufio.NewWriter(w)
+	for _, p := range edges(g) {
+		fmt.Fprintf(bw, "%d %d\n", p[0], p[1])
+	}
+	return errors.Wrap(bw.Flush(), "failed to write edge list")
+}
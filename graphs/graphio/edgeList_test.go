@@ -0,0 +1,53 @@
+package graphio
+
+import (
+	"bytes"
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestReadEdgeList(t *testing.T) {
+	var input = `# a path with a branch
+0 1
+  1   2
+
+1 4
+`
+	g, err := ReadEdgeList(strings.NewReader(input), newUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, g.GetV())
+	adjList, _ := g.Adjacent(1)
+	assert.Equal(t, []int{4, 2, 0}, adjList)
+	adjList, _ = g.Adjacent(3)
+	assert.Empty(t, adjList)
+
+	g, err = ReadEdgeList(strings.NewReader(""), newDirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, g.GetV())
+}
+
+func TestReadEdgeList_Errors(t *testing.T) {
+	for _, input := range []string{"0\n", "0 1 2\n", "0 a\n", "0 -1\n"} {
+		_, err := ReadEdgeList(strings.NewReader(input), newUndirectedGraph)
+		assert.Error(t, err, "input %q", input)
+	}
+
+	// A parallel edge cannot be represented in an adjacency matrix.
+	_, err := ReadEdgeList(strings.NewReader("0 1\n# comment\n1 0\n"), undirected.NewAdjacencyMatrixGraph)
+	assert.EqualError(t, err, "line 3: 1-0 is not accepted by the graph")
+}
+
+func TestWriteEdgeList(t *testing.T) {
+	g, err := ReadAlgs4(strings.NewReader(tinyG), newDirectedGraph)
+	assert.NoError(t, err)
+	var buf = new(bytes.Buffer)
+	assert.NoError(t, WriteEdgeList(buf, g))
+	// Edges are written in the order in which they were added for every vertex.
+	assert.True(t, strings.HasPrefix(buf.String(), "0 5\n0 1\n0 2\n0 6\n4 3\n"))
+
+	readBack, err := ReadEdgeList(buf, newDirectedGraph)
+	assert.NoError(t, err)
+	testSameGraph(t, g, readBack)
+}
@@ -0,0 +1,61 @@
+// Package graphio reads and writes graphs in text formats.
+//
+// Graphs are read into any implementation of the graph interfaces using the given constructor,
+// such as undirected.NewUndirectedGraph or directed.NewDirectedGraph.
+package graphio
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+)
+
+// edges returns every edge of the graph exactly once, as pairs of vertices.
+// For a Digraph, the edges are directed from the first vertex to the second.
+// For any other graph, every edge v-w is present in the adjacency lists of both v and w, and is
+// returned when seen from the smaller of the two vertices.
+func edges(g graphs.Graph) [][2]int {
+	_, directed := g.(graphs.Digraph)
+	var pairs = make([][2]int, 0, 0)
+	for v := 0; v < g.GetV(); v++ {
+		var selfLoops = 0
+		adjList, _ := g.Adjacent(v)
+		// Adjacent returns the most recently added edges first.
+		for i := len(adjList) - 1; i >= 0; i-- {
+			w := adjList[i]
+			switch {
+			case directed || (v < w):
+				pairs = append(pairs, [2]int{v, w})
+			case v == w:
+				// Self-loops are present twice in the same adjacency list.
+				if selfLoops%2 == 0 {
+					pairs = append(pairs, [2]int{v, w})
+				}
+				selfLoops++
+			}
+		}
+	}
+	return pairs
+}
+
+// validateEdge returns error if either vertex of the edge v-w is not one of the numVertices vertices.
+func validateEdge(numVertices, v, w int) error {
+	for _, x := range []int{v, w} {
+		if (x < 0) || (x >= numVertices) {
+			return errors.Errorf("%d-%d has vertex %d that does not exist", v, w, x)
+		}
+	}
+	return nil
+}
+
+// addEdge adds the edge v-w to the graph.
+// Returns error if either vertex does not exist, or if the graph rejects the edge, such as a
+// parallel edge in an undirected.AdjacencyMatrixGraph.
+func addEdge(g graphs.Graph, v, w int) error {
+	if err := validateEdge(g.GetV(), v, w); err != nil {
+		return err
+	}
+	if !g.AddEdge(v, w) {
+		return errors.Errorf("%d-%d is not accepted by the graph", v, w)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package mst
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/unionfind"
+	"sort"
+)
+
+// Kruskal computes a minimum spanning forest of an edge-weighted undirected graph.
+// Algorithm taken from https://algs4.cs.princeton.edu/43mst/.
+//
+// Edges are considered in increasing order of their weights and an edge is added to the
+// forest unless it connects two vertices that are already in the same tree.
+// A union-find is used to keep track of the trees.
+// The edges stop being considered once the union-find is down to a single tree, as every vertex
+// is then spanned. Otherwise, the forest has a tree for each connected component.
+func Kruskal(g graphs.WeightedGraph) *MinimumSpanningForest {
+	var forest = &MinimumSpanningForest{edges: make([]graphs.Edge, 0)}
+	var edges = g.Edges()
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight() < edges[j].Weight()
+	})
+
+	var uf = unionfind.NewWeightedQuickUnion(g.GetV())
+	for _, e := range edges {
+		v := e.Either()
+		w, _ := e.Other(v)
+		if !uf.Union(v, w) {
+			// Already in the same tree.
+			continue
+		}
+		forest.addEdge(e)
+		if uf.Count() == 1 {
+			break
+		}
+	}
+	return forest
+}
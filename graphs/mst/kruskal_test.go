@@ -0,0 +1,68 @@
+package mst
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// getWeightedUndirectedGraph returns the graph in tinyEWG.txt.
+// The edges are taken from https://algs4.cs.princeton.edu/43mst/.
+func getWeightedUndirectedGraph(t *testing.T) graphs.WeightedGraph {
+	wg := undirected.NewWeightedUndirectedGraph(8)
+	assert.NotNil(t, wg)
+	var edges = []graphs.Edge{
+		graphs.NewEdge(4, 5, 0.35), graphs.NewEdge(4, 7, 0.37), graphs.NewEdge(5, 7, 0.28),
+		graphs.NewEdge(0, 7, 0.16), graphs.NewEdge(1, 5, 0.32), graphs.NewEdge(0, 4, 0.38),
+		graphs.NewEdge(2, 3, 0.17), graphs.NewEdge(1, 7, 0.19), graphs.NewEdge(0, 2, 0.26),
+		graphs.NewEdge(1, 2, 0.36), graphs.NewEdge(1, 3, 0.29), graphs.NewEdge(2, 7, 0.34),
+		graphs.NewEdge(6, 2, 0.40), graphs.NewEdge(3, 6, 0.52), graphs.NewEdge(6, 0, 0.58),
+		graphs.NewEdge(6, 4, 0.93),
+	}
+	for _, e := range edges {
+		wg.AddWeightedEdge(e.From(), e.To(), e.Weight())
+	}
+	return wg
+}
+
+// getDisconnectedWeightedUndirectedGraph returns a graph with three connected components,
+// {0, 1, 2}, {3, 4} and {5}.
+func getDisconnectedWeightedUndirectedGraph(t *testing.T) graphs.WeightedGraph {
+	wg := undirected.NewWeightedUndirectedGraph(6)
+	assert.NotNil(t, wg)
+	wg.AddWeightedEdge(0, 1, 1.0)
+	wg.AddWeightedEdge(1, 2, 2.0)
+	wg.AddWeightedEdge(2, 0, 3.0)
+	wg.AddWeightedEdge(3, 4, 4.0)
+	wg.AddWeightedEdge(4, 3, 0.5)
+	wg.AddWeightedEdge(5, 5, 0.1)
+	return wg
+}
+
+// mstFunc computes a minimum spanning forest.
+type mstFunc func(graphs.WeightedGraph) *MinimumSpanningForest
+
+func testMinimumSpanningForest(t *testing.T, mst mstFunc) {
+	forest := mst(getWeightedUndirectedGraph(t))
+	assert.InDelta(t, 1.81, forest.Weight(), 1e-9)
+	assert.ElementsMatch(t, []graphs.Edge{
+		graphs.NewEdge(0, 7, 0.16), graphs.NewEdge(1, 7, 0.19), graphs.NewEdge(0, 2, 0.26),
+		graphs.NewEdge(2, 3, 0.17), graphs.NewEdge(5, 7, 0.28), graphs.NewEdge(4, 5, 0.35),
+		graphs.NewEdge(6, 2, 0.40),
+	}, forest.Edges())
+
+	forest = mst(getDisconnectedWeightedUndirectedGraph(t))
+	assert.InDelta(t, 3.5, forest.Weight(), 1e-9)
+	assert.ElementsMatch(t, []graphs.Edge{
+		graphs.NewEdge(0, 1, 1.0), graphs.NewEdge(1, 2, 2.0), graphs.NewEdge(4, 3, 0.5),
+	}, forest.Edges())
+
+	forest = mst(undirected.NewWeightedUndirectedGraph(3))
+	assert.Empty(t, forest.Edges())
+	assert.Zero(t, forest.Weight())
+}
+
+func TestKruskal(t *testing.T) {
+	testMinimumSpanningForest(t, Kruskal)
+}
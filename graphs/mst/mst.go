@@ -0,0 +1,28 @@
+package mst
+
+import "github.com/pradykaushik/data-structures/graphs"
+
+// MinimumSpanningForest is the result of a minimum spanning tree computation.
+//
+// If the graph is connected, then the forest is a single minimum spanning tree with V-1 edges.
+// Otherwise, it contains a minimum spanning tree for each of the connected components of the
+// graph, i.e., the ones returned by FindConnectedComponents, and V-C edges for C components.
+type MinimumSpanningForest struct {
+	edges  []graphs.Edge
+	weight float64
+}
+
+func (f *MinimumSpanningForest) addEdge(e graphs.Edge) {
+	f.edges = append(f.edges, e)
+	f.weight += e.Weight()
+}
+
+// Edges returns the edges in the forest in the order in which they were added.
+func (f MinimumSpanningForest) Edges() []graphs.Edge {
+	return f.edges
+}
+
+// Weight returns the sum of the weights of the edges in the forest.
+func (f MinimumSpanningForest) Weight() float64 {
+	return f.weight
+}
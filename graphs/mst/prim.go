@@ -0,0 +1,112 @@
+package mst
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/heap"
+	"github.com/pradykaushik/data-structures/util"
+	"math"
+)
+
+// lessWeight compares two edges stored in a priority queue by their weights.
+func lessWeight(e1, e2 util.Value) bool {
+	return e1.Get().(graphs.Edge).Weight() < e2.Get().(graphs.Edge).Weight()
+}
+
+// LazyPrim computes a minimum spanning forest of an edge-weighted undirected graph.
+// Algorithm taken from https://algs4.cs.princeton.edu/43mst/.
+//
+// A tree is grown from a vertex by repeatedly adding the lightest edge that connects a vertex
+// in the tree to one outside it. All the edges incident on the tree are kept in a priority
+// queue, and edges with both endpoints in the tree are lazily discarded when removed from it.
+// A new tree is grown from every vertex that is not yet part of the forest, giving a tree for
+// each connected component.
+func LazyPrim(g graphs.WeightedGraph) *MinimumSpanningForest {
+	var forest = &MinimumSpanningForest{edges: make([]graphs.Edge, 0)}
+	var marked = make([]bool, g.GetV())
+	var pq = heap.NewMinPQ(lessWeight)
+
+	// visit adds v to the tree and queues the edges connecting v to vertices outside the tree.
+	var visit = func(v int) {
+		marked[v] = true
+		adjEdges, _ := g.AdjacentEdges(v)
+		for _, e := range adjEdges {
+			if w, _ := e.Other(v); !marked[w] {
+				pq.Insert(e)
+			}
+		}
+	}
+
+	for s := 0; s < g.GetV(); s++ {
+		if marked[s] {
+			continue
+		}
+		visit(s)
+		for !pq.IsEmpty() {
+			val, _ := pq.DeleteMin()
+			e := val.Get().(graphs.Edge)
+			v := e.Either()
+			w, _ := e.Other(v)
+			if marked[v] && marked[w] {
+				// Both the endpoints are already in the tree.
+				continue
+			}
+			forest.addEdge(e)
+			if !marked[v] {
+				visit(v)
+			}
+			if !marked[w] {
+				visit(w)
+			}
+		}
+	}
+	return forest
+}
+
+// EagerPrim computes a minimum spanning forest of an edge-weighted undirected graph.
+// Algorithm taken from https://algs4.cs.princeton.edu/43mst/.
+//
+// Instead of keeping all the edges incident on the tree, only the lightest edge connecting
+// every vertex outside the tree to the tree is kept. The vertices are kept in an indexed
+// priority queue keyed by the weight of that edge.
+// As with LazyPrim, a new tree is grown from every vertex that is not yet part of the forest.
+func EagerPrim(g graphs.WeightedGraph) *MinimumSpanningForest {
+	var forest = &MinimumSpanningForest{edges: make([]graphs.Edge, 0)}
+	var marked = make([]bool, g.GetV())
+	var edgeTo = make([]graphs.Edge, g.GetV())
+	var distTo = make([]float64, g.GetV())
+	for v := range distTo {
+		distTo[v] = math.Inf(1)
+	}
+	var pq = heap.NewIndexMinPQ(g.GetV())
+
+	for s := 0; s < g.GetV(); s++ {
+		if marked[s] {
+			continue
+		}
+		distTo[s] = 0
+		pq.Insert(s, 0)
+		for !pq.IsEmpty() {
+			v, _ := pq.DeleteMin()
+			if v != s {
+				forest.addEdge(edgeTo[v])
+			}
+			marked[v] = true
+			adjEdges, _ := g.AdjacentEdges(v)
+			for _, e := range adjEdges {
+				w, _ := e.Other(v)
+				if marked[w] || (e.Weight() >= distTo[w]) {
+					continue
+				}
+				// e is now the lightest edge connecting w to the tree.
+				edgeTo[w] = e
+				distTo[w] = e.Weight()
+				if pq.Contains(w) {
+					pq.ChangeKey(w, distTo[w])
+				} else {
+					pq.Insert(w, distTo[w])
+				}
+			}
+		}
+	}
+	return forest
+}
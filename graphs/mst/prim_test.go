@@ -0,0 +1,33 @@
+package mst
+
+import (
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestLazyPrim(t *testing.T) {
+	testMinimumSpanningForest(t, LazyPrim)
+}
+
+func TestEagerPrim(t *testing.T) {
+	testMinimumSpanningForest(t, EagerPrim)
+}
+
+func TestPrim_AgreesWithKruskal(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		wg := undirected.NewWeightedUndirectedGraph(20)
+		for j := 0; j < 30; j++ {
+			wg.AddWeightedEdge(r.Intn(20), r.Intn(20), r.Float64())
+		}
+		kruskal := Kruskal(wg)
+		lazy := LazyPrim(wg)
+		eager := EagerPrim(wg)
+		assert.Len(t, lazy.Edges(), len(kruskal.Edges()))
+		assert.Len(t, eager.Edges(), len(kruskal.Edges()))
+		assert.InDelta(t, kruskal.Weight(), lazy.Weight(), 1e-9)
+		assert.InDelta(t, kruskal.Weight(), eager.Weight(), 1e-9)
+	}
+}
@@ -0,0 +1,29 @@
+package graphs
+
+import "fmt"
+
+// Pair is an unweighted edge, given by its two endpoints. It is used for the results that only
+// need to identify the edges, such as bridges and matchings, where an Edge would carry a
+// meaningless weight.
+//
+// For directed graphs, the edge points from From() to To().
+type Pair [2]int
+
+// NewPair returns the pair of the two vertices.
+func NewPair(from, to int) Pair {
+	return Pair{from, to}
+}
+
+// From returns the first vertex of the pair.
+func (p Pair) From() int {
+	return p[0]
+}
+
+// To returns the second vertex of the pair.
+func (p Pair) To() int {
+	return p[1]
+}
+
+func (p Pair) String() string {
+	return fmt.Sprintf("%d-%d", p[0], p[1])
+}
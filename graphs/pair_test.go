@@ -0,0 +1,14 @@
+package graphs
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewPair(t *testing.T) {
+	p := NewPair(4, 5)
+	assert.Equal(t, 4, p.From())
+	assert.Equal(t, 5, p.To())
+	assert.Equal(t, Pair{4, 5}, p)
+	assert.Equal(t, "4-5", p.String())
+}
@@ -0,0 +1,96 @@
+package shortestpath
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/heap"
+	"math"
+)
+
+// Heuristic estimates the length of the shortest path from the given vertex to the destination.
+type Heuristic func(int) float64
+
+// AStarResult is the result of an A* search from a source to a destination.
+type AStarResult struct {
+	path     []int
+	cost     float64
+	expanded int
+}
+
+// Path returns the vertices on the shortest path from the source to the destination.
+// Return false if the destination is not reachable from the source.
+func (r AStarResult) Path() ([]int, bool) {
+	return r.path, len(r.path) > 0
+}
+
+// Cost returns the length of the shortest path, or +Inf if the destination is not reachable.
+func (r AStarResult) Cost() float64 {
+	return r.cost
+}
+
+// Expanded returns the number of times a vertex was removed from the priority queue and had its
+// edges relaxed. Better heuristics result in fewer vertices being expanded.
+func (r AStarResult) Expanded() int {
+	return r.expanded
+}
+
+// AStar finds the shortest path from source to dest using the A* search algorithm.
+// The graph can either be directed or undirected, but all the edge weights must be non-negative.
+// Returns error if either of the vertices does not exist or if any of the edges has a negative weight.
+//
+// Like Dijkstra, vertices are removed from a priority queue and their edges relaxed, but the
+// priority of v is distTo[v] + h(v), the estimated length of the shortest path through v. This
+// guides the search towards the destination. The path found is the shortest one if the heuristic
+// never overestimates, i.e., is admissible. A nil heuristic always returns 0, which makes
+// the search equivalent to Dijkstra.
+//
+// If the heuristic is also consistent, i.e., h(v) <= w(v, x) + h(x) for every edge v->x, then every
+// vertex is expanded at most once. Otherwise, a vertex is expanded again whenever a shorter path
+// to it is found.
+func AStar(g graphs.WeightedGraph, source, dest int, h Heuristic) (*AStarResult, error) {
+	if (source < 0) || (source >= g.GetV()) {
+		return nil, errors.Errorf("source vertex %d does not exist", source)
+	}
+	if (dest < 0) || (dest >= g.GetV()) {
+		return nil, errors.Errorf("destination vertex %d does not exist", dest)
+	}
+	for _, e := range g.Edges() {
+		if e.Weight() < 0 {
+			return nil, errors.Errorf("edge %v has negative weight", e)
+		}
+	}
+	if h == nil {
+		h = func(int) float64 { return 0 }
+	}
+
+	var result = &AStarResult{
+		path:     []int{},
+		cost:     math.Inf(1),
+		expanded: 0,
+	}
+	var sp = newShortestPaths(g.GetV(), source)
+	var pq = heap.NewIndexMinPQ(g.GetV())
+	pq.Insert(source, h(source))
+	for !pq.IsEmpty() {
+		v, _ := pq.DeleteMin()
+		if v == dest {
+			result.cost = sp.distTo[dest]
+			result.path, _ = sp.PathTo(dest)
+			return result, nil
+		}
+		result.expanded++
+		adjEdges, _ := g.AdjacentEdges(v)
+		for _, e := range adjEdges {
+			w, relaxed := sp.relax(e, v)
+			if !relaxed {
+				continue
+			}
+			if pq.Contains(w) {
+				pq.ChangeKey(w, sp.distTo[w]+h(w))
+			} else {
+				pq.Insert(w, sp.distTo[w]+h(w))
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,111 @@
+package shortestpath
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/directed"
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+// getGrid returns an n x n grid where vertex r*n+c is connected to its neighbours with
+// edges of weight 1. The cells in blocked have no edges.
+func getGrid(n int, blocked map[int]struct{}) graphs.WeightedGraph {
+	wg := undirected.NewWeightedUndirectedGraph(n * n)
+	for r := 0; r < n; r++ {
+		for c := 0; c < n; c++ {
+			v := r*n + c
+			if _, ok := blocked[v]; ok {
+				continue
+			}
+			if _, ok := blocked[v+1]; !ok && (c+1 < n) {
+				wg.AddWeightedEdge(v, v+1, 1)
+			}
+			if _, ok := blocked[v+n]; !ok && (r+1 < n) {
+				wg.AddWeightedEdge(v, v+n, 1)
+			}
+		}
+	}
+	return wg
+}
+
+// manhattan returns the heuristic for a grid that sums the row and column distances to dest.
+func manhattan(n, dest int) Heuristic {
+	return func(v int) float64 {
+		return math.Abs(float64(v/n-dest/n)) + math.Abs(float64(v%n-dest%n))
+	}
+}
+
+func TestAStar(t *testing.T) {
+	wg := getWeightedDirectedGraph(t)
+	for dest := 0; dest < 8; dest++ {
+		result, err := AStar(wg, 0, dest, nil)
+		assert.NoError(t, err)
+		sp, _ := Dijkstra(wg, 0)
+		expectedPath, _ := sp.PathTo(dest)
+		expectedCost, _ := sp.DistTo(dest)
+		path, found := result.Path()
+		assert.True(t, found)
+		assert.Equal(t, expectedPath, path)
+		assert.InDelta(t, expectedCost, result.Cost(), 1e-9)
+	}
+}
+
+func TestAStar_Grid(t *testing.T) {
+	// A 20 x 20 grid with a wall along column 10 that has a gap in the last row.
+	const n = 20
+	var blocked = make(map[int]struct{})
+	for r := 0; r < n-1; r++ {
+		blocked[r*n+10] = struct{}{}
+	}
+	wg := getGrid(n, blocked)
+	source, dest := 0, n-1
+
+	withoutHeuristic, err := AStar(wg, source, dest, nil)
+	assert.NoError(t, err)
+	withHeuristic, err := AStar(wg, source, dest, manhattan(n, dest))
+	assert.NoError(t, err)
+
+	// Going around the wall requires going down to the last row and back up.
+	var expectedCost = float64(2*(n-1) + (n - 1))
+	assert.InDelta(t, expectedCost, withoutHeuristic.Cost(), 1e-9)
+	assert.InDelta(t, expectedCost, withHeuristic.Cost(), 1e-9)
+	path, found := withHeuristic.Path()
+	assert.True(t, found)
+	assert.Len(t, path, int(expectedCost)+1)
+	assert.Equal(t, source, path[0])
+	assert.Equal(t, dest, path[len(path)-1])
+	assert.Less(t, withHeuristic.Expanded(), withoutHeuristic.Expanded())
+}
+
+func TestAStar_Unreachable(t *testing.T) {
+	wg := directed.NewWeightedDirectedGraph(3)
+	wg.AddWeightedEdge(0, 1, 1.0)
+	result, err := AStar(wg, 0, 2, nil)
+	assert.NoError(t, err)
+	path, found := result.Path()
+	assert.False(t, found)
+	assert.Empty(t, path)
+	assert.True(t, math.IsInf(result.Cost(), 1))
+	assert.Equal(t, 2, result.Expanded())
+
+	result, err = AStar(wg, 1, 1, nil)
+	assert.NoError(t, err)
+	path, found = result.Path()
+	assert.True(t, found)
+	assert.Equal(t, []int{1}, path)
+	assert.Zero(t, result.Cost())
+}
+
+func TestAStar_Errors(t *testing.T) {
+	wg := directed.NewWeightedDirectedGraph(3)
+	wg.AddWeightedEdge(0, 1, 1.0)
+	_, err := AStar(wg, 3, 0, nil)
+	assert.Error(t, err)
+	_, err = AStar(wg, 0, -1, nil)
+	assert.Error(t, err)
+	wg.AddWeightedEdge(1, 2, -1.0)
+	_, err = AStar(wg, 0, 2, nil)
+	assert.Error(t, err)
+}
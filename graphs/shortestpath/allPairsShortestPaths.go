@@ -0,0 +1,66 @@
+package shortestpath
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"math"
+)
+
+// AllPairsShortestPaths is the result of an all-pairs shortest paths computation.
+//
+// dist[u][v] is the length of the shortest path from u to v.
+// edgeTo[u][v] is the last edge on the shortest path from u to v, directed towards v.
+// If v is not reachable from u, then dist[u][v] = +Inf.
+type AllPairsShortestPaths struct {
+	dist   [][]float64
+	edgeTo [][]graphs.Edge
+}
+
+func newAllPairsShortestPaths(v int) *AllPairsShortestPaths {
+	ap := &AllPairsShortestPaths{
+		dist:   make([][]float64, v),
+		edgeTo: make([][]graphs.Edge, v),
+	}
+	for i := 0; i < v; i++ {
+		ap.dist[i] = make([]float64, v)
+		ap.edgeTo[i] = make([]graphs.Edge, v)
+		for j := 0; j < v; j++ {
+			ap.dist[i][j] = math.Inf(1)
+		}
+		ap.dist[i][i] = 0
+	}
+	return ap
+}
+
+func (ap AllPairsShortestPaths) isValid(v int) bool {
+	return (v >= 0) && (v < len(ap.dist))
+}
+
+// Dist returns the length of the shortest path from u to v.
+// The length is +Inf if v is not reachable from u.
+// Return false if either of the vertices does not exist.
+func (ap AllPairsShortestPaths) Dist(u, v int) (float64, bool) {
+	if !ap.isValid(u) || !ap.isValid(v) {
+		return math.Inf(1), false
+	}
+	return ap.dist[u][v], true
+}
+
+// HasPath returns whether there is a path from u to v.
+func (ap AllPairsShortestPaths) HasPath(u, v int) bool {
+	return ap.isValid(u) && ap.isValid(v) && !math.IsInf(ap.dist[u][v], 1)
+}
+
+// Path returns the vertices on the shortest path from u to v.
+//
+// The edges on the path are traced back from v to u.
+func (ap AllPairsShortestPaths) Path(u, v int) ([]int, bool) {
+	if !ap.HasPath(u, v) {
+		return []int{}, false
+	}
+
+	var path = []int{v}
+	for w := v; w != u; w = ap.edgeTo[u][w].From() {
+		path = append([]int{ap.edgeTo[u][w].From()}, path...)
+	}
+	return path, true
+}
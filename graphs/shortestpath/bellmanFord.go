@@ -0,0 +1,128 @@
+package shortestpath
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/queue/fifo"
+)
+
+// vertex implements util.Value and represents a vertex stored in a queue.
+type vertex int
+
+func (v vertex) Get() interface{} {
+	return int(v)
+}
+
+// NegativeCycleError is returned when a cycle whose total weight is negative is reachable
+// from the source vertex. Shortest paths are not defined in this case.
+type NegativeCycleError struct {
+	// Cycle is the sequence of vertices on the cycle.
+	// The first and the last vertices are the same.
+	Cycle []int
+	// Weight is the total weight of the edges on the cycle.
+	Weight float64
+}
+
+func (e NegativeCycleError) Error() string {
+	var buf = new(bytes.Buffer)
+	buf.WriteString("negative cycle: ")
+	for i, v := range e.Cycle {
+		if i > 0 {
+			buf.WriteString(" -> ")
+		}
+		buf.WriteString(fmt.Sprintf("%d", v))
+	}
+	buf.WriteString(fmt.Sprintf(" (weight %.5f)", e.Weight))
+	return buf.String()
+}
+
+// BellmanFord computes the shortest paths from the source vertex to every other vertex.
+// Algorithm taken from https://algs4.cs.princeton.edu/44sp/.
+//
+// Unlike Dijkstra, edges are allowed to have negative weights. If a negative cycle is reachable
+// from the source, then a *NegativeCycleError containing the cycle is returned.
+//
+// This is the queue-based variant of the algorithm. Only the vertices whose distance changed
+// in the previous pass can lead to further relaxations, and are therefore the only ones queued.
+// After every V relaxations, the edges leading to each vertex are checked for a cycle, which
+// could only be a negative one.
+func BellmanFord(g graphs.WeightedDigraph, source int) (*ShortestPaths, error) {
+	if (source < 0) || (source >= g.GetV()) {
+		return nil, errors.Errorf("source vertex %d does not exist", source)
+	}
+
+	var sp = newShortestPaths(g.GetV(), source)
+	var hasEdgeTo = make([]bool, g.GetV())
+	var onQueue = make([]bool, g.GetV())
+	// Every vertex is on the queue at most once.
+	var next = fifo.NewLinearQueueLL(g.GetV())
+	var numRelaxations = 0
+
+	next.Enqueue(vertex(source))
+	onQueue[source] = true
+	for !next.IsEmpty() {
+		nextV, _ := next.Dequeue()
+		v := nextV.Get().(int)
+		onQueue[v] = false
+		adjEdges, _ := g.AdjacentEdges(v)
+		for _, e := range adjEdges {
+			w, relaxed := sp.relax(e, v)
+			if relaxed {
+				hasEdgeTo[w] = true
+				if !onQueue[w] {
+					next.Enqueue(vertex(w))
+					onQueue[w] = true
+				}
+			}
+			numRelaxations++
+			if numRelaxations%g.GetV() == 0 {
+				if cycle, found := findCycle(sp, hasEdgeTo); found {
+					return nil, cycle
+				}
+			}
+		}
+	}
+	return sp, nil
+}
+
+// findCycle looks for a cycle among the edges in edgeTo.
+// As every vertex has at most one edge leading to it, the edges can be followed backwards
+// from every vertex until either a vertex without an edge or a vertex seen before is reached.
+func findCycle(sp *ShortestPaths, hasEdgeTo []bool) (*NegativeCycleError, bool) {
+	// visitedBy[v] records the vertex from which v was first reached, or -1.
+	var visitedBy = make([]int, len(hasEdgeTo))
+	for v := range visitedBy {
+		visitedBy[v] = -1
+	}
+
+	for start := range hasEdgeTo {
+		if visitedBy[start] != -1 {
+			continue
+		}
+		var v = start
+		for (visitedBy[v] == -1) && hasEdgeTo[v] {
+			visitedBy[v] = start
+			v = sp.edgeTo[v].From()
+		}
+		if visitedBy[v] != start {
+			// Reached either a vertex without an edge or one already visited from another start.
+			continue
+		}
+
+		// v is on a cycle. Following the edges backwards from v leads back to v.
+		var cycle = &NegativeCycleError{Cycle: []int{v}}
+		for u := v; ; {
+			e := sp.edgeTo[u]
+			cycle.Cycle = append([]int{e.From()}, cycle.Cycle...)
+			cycle.Weight += e.Weight()
+			u = e.From()
+			if u == v {
+				break
+			}
+		}
+		return cycle, true
+	}
+	return nil, false
+}
@@ -0,0 +1,90 @@
+package shortestpath
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/directed"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// getWeightedDirectedGraphWithNegativeWeights returns the graph in tinyEWDn.txt.
+// The edges are taken from https://algs4.cs.princeton.edu/44sp/.
+func getWeightedDirectedGraphWithNegativeWeights(t *testing.T) graphs.WeightedDigraph {
+	wg := directed.NewWeightedDirectedGraph(8)
+	assert.NotNil(t, wg)
+	var edges = []graphs.Edge{
+		graphs.NewEdge(4, 5, 0.35), graphs.NewEdge(5, 4, 0.35), graphs.NewEdge(4, 7, 0.37),
+		graphs.NewEdge(5, 7, 0.28), graphs.NewEdge(7, 5, 0.28), graphs.NewEdge(5, 1, 0.32),
+		graphs.NewEdge(0, 4, 0.38), graphs.NewEdge(0, 2, 0.26), graphs.NewEdge(7, 3, 0.39),
+		graphs.NewEdge(1, 3, 0.29), graphs.NewEdge(2, 7, 0.34), graphs.NewEdge(6, 2, -1.20),
+		graphs.NewEdge(3, 6, 0.52), graphs.NewEdge(6, 0, -1.40), graphs.NewEdge(6, 4, -1.25),
+	}
+	for _, e := range edges {
+		wg.AddWeightedEdge(e.From(), e.To(), e.Weight())
+	}
+	return wg
+}
+
+func TestBellmanFord(t *testing.T) {
+	// Without negative weights, the result should match that of Dijkstra.
+	sp, err := BellmanFord(getWeightedDirectedGraph(t), 0)
+	assert.NoError(t, err)
+	testShortestPaths(t, sp)
+}
+
+func TestBellmanFord_NegativeWeights(t *testing.T) {
+	sp, err := BellmanFord(getWeightedDirectedGraphWithNegativeWeights(t), 0)
+	assert.NoError(t, err)
+	var expectedDist = []float64{0.00, 0.93, 0.26, 0.99, 0.26, 0.61, 1.51, 0.60}
+	for v := 0; v < 8; v++ {
+		dist, validVertex := sp.DistTo(v)
+		assert.True(t, validVertex)
+		assert.InDelta(t, expectedDist[v], dist, 1e-9)
+	}
+	path, found := sp.PathTo(1)
+	assert.True(t, found)
+	assert.Equal(t, []int{0, 2, 7, 3, 6, 4, 5, 1}, path)
+}
+
+func TestBellmanFord_NegativeCycle(t *testing.T) {
+	// tinyEWDnc.txt is tinyEWD.txt with the weight of 5->4 changed to -0.66.
+	wg := directed.NewWeightedDirectedGraph(8)
+	for _, e := range getWeightedDirectedGraph(t).Edges() {
+		if (e.From() == 5) && (e.To() == 4) {
+			wg.AddWeightedEdge(5, 4, -0.66)
+			continue
+		}
+		wg.AddWeightedEdge(e.From(), e.To(), e.Weight())
+	}
+
+	sp, err := BellmanFord(wg, 0)
+	assert.Nil(t, sp)
+	assert.Error(t, err)
+	cycleErr, ok := err.(*NegativeCycleError)
+	assert.True(t, ok)
+	assert.InDelta(t, -0.31, cycleErr.Weight, 1e-9)
+	assert.Len(t, cycleErr.Cycle, 3)
+	assert.Equal(t, cycleErr.Cycle[0], cycleErr.Cycle[2])
+	assert.ElementsMatch(t, []int{4, 5}, cycleErr.Cycle[:2])
+	assert.Contains(t, err.Error(), "negative cycle")
+}
+
+func TestBellmanFord_UnreachableNegativeCycle(t *testing.T) {
+	wg := directed.NewWeightedDirectedGraph(4)
+	wg.AddWeightedEdge(0, 1, 1.0)
+	wg.AddWeightedEdge(2, 3, -1.0)
+	wg.AddWeightedEdge(3, 2, -1.0)
+	sp, err := BellmanFord(wg, 0)
+	assert.NoError(t, err)
+	assert.True(t, sp.HasPathTo(1))
+	assert.False(t, sp.HasPathTo(2))
+
+	// Negative self-loop on the source.
+	wg.AddWeightedEdge(0, 0, -0.5)
+	_, err = BellmanFord(wg, 0)
+	assert.Error(t, err)
+	assert.Equal(t, []int{0, 0}, err.(*NegativeCycleError).Cycle)
+
+	_, err = BellmanFord(wg, 4)
+	assert.Error(t, err)
+}
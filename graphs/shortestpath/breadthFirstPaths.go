@@ -0,0 +1,103 @@
+package shortestpath
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/queue/fifo"
+)
+
+// BreadthFirstPaths is the result of a bfs from one or more source vertices.
+// It gives the paths with the fewest edges from the closest source to every reachable vertex.
+// API taken from https://algs4.cs.princeton.edu/41graph/.
+//
+// distTo[v] is the number of edges on the shortest path to v, or -1 if v is not reachable.
+// edgeTo[v] is the vertex before v on the shortest path to v, or -1 if v is a source or not reachable.
+type BreadthFirstPaths struct {
+	sources []int
+	distTo  []int
+	edgeTo  []int
+}
+
+// NewBreadthFirstPaths runs a bfs on the graph from all the given sources at once.
+// The graph can either be directed or undirected. The path to a vertex starts at the source
+// closest to it.
+// Return false if no source is given or if any of the sources does not exist.
+func NewBreadthFirstPaths(g graphs.Graph, sources ...int) (*BreadthFirstPaths, bool) {
+	if len(sources) == 0 {
+		return nil, false
+	}
+	for _, s := range sources {
+		if (s < 0) || (s >= g.GetV()) {
+			return nil, false
+		}
+	}
+
+	var bfp = &BreadthFirstPaths{
+		sources: sources,
+		distTo:  make([]int, g.GetV()),
+		edgeTo:  make([]int, g.GetV()),
+	}
+	for v := range bfp.distTo {
+		bfp.distTo[v] = -1
+		bfp.edgeTo[v] = -1
+	}
+
+	// Every vertex is queued at most once.
+	var next = fifo.NewLinearQueueArr(g.GetV())
+	for _, s := range sources {
+		if bfp.distTo[s] == -1 {
+			bfp.distTo[s] = 0
+			next.Enqueue(vertex(s))
+		}
+	}
+	for !next.IsEmpty() {
+		nextV, _ := next.Dequeue()
+		v := nextV.Get().(int)
+		adjList, _ := g.Adjacent(v)
+		for _, w := range adjList {
+			if bfp.distTo[w] == -1 {
+				bfp.distTo[w] = bfp.distTo[v] + 1
+				bfp.edgeTo[w] = v
+				next.Enqueue(vertex(w))
+			}
+		}
+	}
+	return bfp, true
+}
+
+// Sources returns the vertices from which the bfs was run.
+func (bfp BreadthFirstPaths) Sources() []int {
+	return bfp.sources
+}
+
+func (bfp BreadthFirstPaths) isValid(v int) bool {
+	return (v >= 0) && (v < len(bfp.distTo))
+}
+
+// HasPathTo returns whether there is a path from any of the sources to the given vertex.
+func (bfp BreadthFirstPaths) HasPathTo(v int) bool {
+	return bfp.isValid(v) && (bfp.distTo[v] != -1)
+}
+
+// DistTo returns the number of edges on the shortest path from the closest source to the given vertex.
+// The distance is -1 if the vertex is not reachable from any of the sources.
+// Return false if vertex does not exist.
+func (bfp BreadthFirstPaths) DistTo(v int) (int, bool) {
+	if !bfp.isValid(v) {
+		return -1, false
+	}
+	return bfp.distTo[v], true
+}
+
+// PathTo returns the vertices on the shortest path from the closest source to the given vertex.
+// Return false if the vertex is not reachable from any of the sources.
+func (bfp BreadthFirstPaths) PathTo(dest int) ([]int, bool) {
+	if !bfp.HasPathTo(dest) {
+		return []int{}, false
+	}
+
+	var path = make([]int, bfp.distTo[dest]+1)
+	for i, v := len(path)-1, dest; i >= 0; i, v = i-1, bfp.edgeTo[v] {
+		path[i] = v
+	}
+	return path, true
+}
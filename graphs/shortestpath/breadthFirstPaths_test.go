@@ -0,0 +1,114 @@
+package shortestpath
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/directed"
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// getUndirectedGraph returns the graph in tinyG.txt.
+// The pairs are taken from https://algs4.cs.princeton.edu/41graph/.
+func getUndirectedGraph(t *testing.T) graphs.Graph {
+	ug := undirected.NewUndirectedGraph(13)
+	assert.NotNil(t, ug)
+	var pairs = [][]int{
+		{0, 5}, {4, 3}, {0, 1}, {9, 12}, {6, 4}, {5, 4}, {0, 2},
+		{11, 12}, {9, 10}, {0, 6}, {7, 8}, {9, 11}, {5, 3},
+	}
+	for _, p := range pairs {
+		ug.AddEdge(p[0], p[1])
+	}
+	return ug
+}
+
+// testBfsPath checks that the path to dest has the expected number of edges, starts at one of
+// the sources and follows the edges of the graph.
+func testBfsPath(t *testing.T, g graphs.Graph, bfp *BreadthFirstPaths, dest, expectedDist int) {
+	dist, validVertex := bfp.DistTo(dest)
+	assert.True(t, validVertex)
+	assert.Equal(t, expectedDist, dist)
+	assert.True(t, bfp.HasPathTo(dest))
+	path, found := bfp.PathTo(dest)
+	assert.True(t, found)
+	assert.Len(t, path, expectedDist+1)
+	assert.Contains(t, bfp.Sources(), path[0])
+	assert.Equal(t, dest, path[len(path)-1])
+	for i := 1; i < len(path); i++ {
+		adjList, _ := g.Adjacent(path[i-1])
+		assert.Contains(t, adjList, path[i])
+	}
+}
+
+func TestNewBreadthFirstPaths(t *testing.T) {
+	ug := getUndirectedGraph(t)
+	bfp, ok := NewBreadthFirstPaths(ug, 0)
+	assert.True(t, ok)
+	assert.Equal(t, []int{0}, bfp.Sources())
+
+	var expectedDist = []int{0, 1, 1, 2, 2, 1, 1}
+	for v, dist := range expectedDist {
+		testBfsPath(t, ug, bfp, v, dist)
+	}
+	path, _ := bfp.PathTo(3)
+	assert.Equal(t, []int{0, 5, 3}, path)
+
+	for v := 7; v < 13; v++ {
+		assert.False(t, bfp.HasPathTo(v))
+		dist, validVertex := bfp.DistTo(v)
+		assert.True(t, validVertex)
+		assert.Equal(t, -1, dist)
+		path, found := bfp.PathTo(v)
+		assert.False(t, found)
+		assert.Empty(t, path)
+	}
+
+	assert.False(t, bfp.HasPathTo(13))
+	_, validVertex := bfp.DistTo(13)
+	assert.False(t, validVertex)
+	_, found := bfp.PathTo(-1)
+	assert.False(t, found)
+}
+
+func TestNewBreadthFirstPaths_MultipleSources(t *testing.T) {
+	ug := getUndirectedGraph(t)
+	bfp, ok := NewBreadthFirstPaths(ug, 3, 9, 3)
+	assert.True(t, ok)
+
+	var expectedDist = map[int]int{
+		0: 2, 1: 3, 2: 3, 3: 0, 4: 1, 5: 1, 6: 2,
+		9: 0, 10: 1, 11: 1, 12: 1,
+	}
+	for v, dist := range expectedDist {
+		testBfsPath(t, ug, bfp, v, dist)
+	}
+	assert.False(t, bfp.HasPathTo(7))
+	assert.False(t, bfp.HasPathTo(8))
+}
+
+func TestNewBreadthFirstPaths_Directed(t *testing.T) {
+	dg := directed.NewDirectedGraph(5)
+	dg.AddEdge(0, 1)
+	dg.AddEdge(1, 2)
+	dg.AddEdge(2, 3)
+	dg.AddEdge(0, 3)
+	dg.AddEdge(4, 0)
+
+	bfp, ok := NewBreadthFirstPaths(dg, 0)
+	assert.True(t, ok)
+	testBfsPath(t, dg, bfp, 3, 1)
+	testBfsPath(t, dg, bfp, 2, 2)
+	// Edges cannot be traversed backwards.
+	assert.False(t, bfp.HasPathTo(4))
+}
+
+func TestNewBreadthFirstPaths_InvalidSources(t *testing.T) {
+	ug := getUndirectedGraph(t)
+	_, ok := NewBreadthFirstPaths(ug)
+	assert.False(t, ok)
+	_, ok = NewBreadthFirstPaths(ug, 0, 13)
+	assert.False(t, ok)
+	_, ok = NewBreadthFirstPaths(ug, -1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,47 @@
+package shortestpath
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/heap"
+)
+
+// Dijkstra computes the shortest paths from the source vertex to every other vertex.
+// Algorithm taken from https://algs4.cs.princeton.edu/44sp/.
+//
+// The graph can either be directed or undirected, but all the edge weights must be non-negative.
+// Returns error if the source vertex does not exist or if any of the edges has a negative weight.
+//
+// Vertices are removed from an indexed min priority queue in increasing order of their distance
+// from the source. As all the weights are non-negative, the distance to a vertex is final once
+// it has been removed from the queue.
+func Dijkstra(g graphs.WeightedGraph, source int) (*ShortestPaths, error) {
+	if (source < 0) || (source >= g.GetV()) {
+		return nil, errors.Errorf("source vertex %d does not exist", source)
+	}
+	for _, e := range g.Edges() {
+		if e.Weight() < 0 {
+			return nil, errors.Errorf("edge %v has negative weight", e)
+		}
+	}
+
+	var sp = newShortestPaths(g.GetV(), source)
+	var pq = heap.NewIndexMinPQ(g.GetV())
+	pq.Insert(source, 0)
+	for !pq.IsEmpty() {
+		v, _ := pq.DeleteMin()
+		adjEdges, _ := g.AdjacentEdges(v)
+		for _, e := range adjEdges {
+			w, relaxed := sp.relax(e, v)
+			if !relaxed {
+				continue
+			}
+			if pq.Contains(w) {
+				pq.ChangeKey(w, sp.distTo[w])
+			} else {
+				pq.Insert(w, sp.distTo[w])
+			}
+		}
+	}
+	return sp, nil
+}
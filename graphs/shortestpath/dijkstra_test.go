@@ -0,0 +1,119 @@
+package shortestpath
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/directed"
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+// getWeightedDirectedGraph returns the graph in tinyEWD.txt.
+// The edges are taken from https://algs4.cs.princeton.edu/44sp/.
+func getWeightedDirectedGraph(t *testing.T) graphs.WeightedDigraph {
+	wg := directed.NewWeightedDirectedGraph(8)
+	assert.NotNil(t, wg)
+	var edges = []graphs.Edge{
+		graphs.NewEdge(4, 5, 0.35), graphs.NewEdge(5, 4, 0.35), graphs.NewEdge(4, 7, 0.37),
+		graphs.NewEdge(5, 7, 0.28), graphs.NewEdge(7, 5, 0.28), graphs.NewEdge(5, 1, 0.32),
+		graphs.NewEdge(0, 4, 0.38), graphs.NewEdge(0, 2, 0.26), graphs.NewEdge(7, 3, 0.39),
+		graphs.NewEdge(1, 3, 0.29), graphs.NewEdge(2, 7, 0.34), graphs.NewEdge(6, 2, 0.40),
+		graphs.NewEdge(3, 6, 0.52), graphs.NewEdge(6, 0, 0.58), graphs.NewEdge(6, 4, 0.93),
+	}
+	for _, e := range edges {
+		wg.AddWeightedEdge(e.From(), e.To(), e.Weight())
+	}
+	return wg
+}
+
+// testShortestPaths checks the shortest paths from 0 in tinyEWD.txt.
+func testShortestPaths(t *testing.T, sp *ShortestPaths) {
+	var expectedDist = []float64{0.00, 1.05, 0.26, 0.99, 0.38, 0.73, 1.51, 0.60}
+	var expectedPaths = [][]int{
+		{0},
+		{0, 4, 5, 1},
+		{0, 2},
+		{0, 2, 7, 3},
+		{0, 4},
+		{0, 4, 5},
+		{0, 2, 7, 3, 6},
+		{0, 2, 7},
+	}
+	assert.Equal(t, 0, sp.Source())
+	for v := 0; v < 8; v++ {
+		assert.True(t, sp.HasPathTo(v))
+		dist, validVertex := sp.DistTo(v)
+		assert.True(t, validVertex)
+		assert.InDelta(t, expectedDist[v], dist, 1e-9)
+		path, found := sp.PathTo(v)
+		assert.True(t, found)
+		assert.Equal(t, expectedPaths[v], path)
+	}
+
+	_, found := sp.EdgeTo(0)
+	assert.False(t, found)
+	e, found := sp.EdgeTo(6)
+	assert.True(t, found)
+	assert.Equal(t, graphs.NewEdge(3, 6, 0.52), e)
+}
+
+func TestDijkstra(t *testing.T) {
+	sp, err := Dijkstra(getWeightedDirectedGraph(t), 0)
+	assert.NoError(t, err)
+	testShortestPaths(t, sp)
+}
+
+func TestDijkstra_Unreachable(t *testing.T) {
+	wg := directed.NewWeightedDirectedGraph(3)
+	wg.AddWeightedEdge(0, 1, 1.0)
+	wg.AddWeightedEdge(2, 0, 1.0)
+	sp, err := Dijkstra(wg, 0)
+	assert.NoError(t, err)
+	assert.False(t, sp.HasPathTo(2))
+	dist, validVertex := sp.DistTo(2)
+	assert.True(t, validVertex)
+	assert.True(t, math.IsInf(dist, 1))
+	path, found := sp.PathTo(2)
+	assert.False(t, found)
+	assert.Empty(t, path)
+	_, found = sp.EdgeTo(2)
+	assert.False(t, found)
+
+	_, validVertex = sp.DistTo(3)
+	assert.False(t, validVertex)
+	assert.False(t, sp.HasPathTo(-1))
+}
+
+func TestDijkstra_Undirected(t *testing.T) {
+	wg := undirected.NewWeightedUndirectedGraph(4)
+	wg.AddWeightedEdge(1, 0, 1.0)
+	wg.AddWeightedEdge(2, 1, 1.0)
+	wg.AddWeightedEdge(0, 2, 3.0)
+	wg.AddWeightedEdge(3, 2, 0.5)
+	sp, err := Dijkstra(wg, 0)
+	assert.NoError(t, err)
+	dist, _ := sp.DistTo(3)
+	assert.InDelta(t, 2.5, dist, 1e-9)
+	path, found := sp.PathTo(3)
+	assert.True(t, found)
+	assert.Equal(t, []int{0, 1, 2, 3}, path)
+	// Edges are recorded in the direction of travel.
+	e, _ := sp.EdgeTo(1)
+	assert.Equal(t, 0, e.From())
+	assert.Equal(t, 1, e.To())
+}
+
+func TestDijkstra_Errors(t *testing.T) {
+	wg := directed.NewWeightedDirectedGraph(3)
+	wg.AddWeightedEdge(0, 1, 1.0)
+	_, err := Dijkstra(wg, 3)
+	assert.Error(t, err)
+	_, err = Dijkstra(wg, -1)
+	assert.Error(t, err)
+
+	wg.AddWeightedEdge(1, 2, -0.5)
+	_, err = Dijkstra(wg, 0)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "negative weight")
+}
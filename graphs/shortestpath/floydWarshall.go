@@ -0,0 +1,69 @@
+package shortestpath
+
+import "github.com/pradykaushik/data-structures/graphs"
+
+// FloydWarshall computes the shortest paths between every pair of vertices.
+// Algorithm taken from https://algs4.cs.princeton.edu/44sp/.
+//
+// The graph is stored as a V x V matrix, making this suitable for dense graphs. For sparse graphs,
+// Johnson is faster. Edges are allowed to have negative weights. If the graph contains a negative
+// cycle, then a *NegativeCycleError containing the cycle is returned.
+//
+// After the k-th iteration, dist[u][v] is the length of the shortest path from u to v that
+// only passes through the vertices 0 to k.
+func FloydWarshall(g graphs.WeightedGraph) (*AllPairsShortestPaths, error) {
+	var numVertices = g.GetV()
+	var ap = newAllPairsShortestPaths(numVertices)
+	for u := 0; u < numVertices; u++ {
+		adjEdges, _ := g.AdjacentEdges(u)
+		for _, e := range adjEdges {
+			e = directedFrom(e, u)
+			// Only the lightest of the parallel edges is of interest.
+			if e.Weight() < ap.dist[u][e.To()] {
+				ap.dist[u][e.To()] = e.Weight()
+				ap.edgeTo[u][e.To()] = e
+			}
+		}
+	}
+
+	for k := 0; k < numVertices; k++ {
+		for u := 0; u < numVertices; u++ {
+			if !ap.HasPath(u, k) {
+				continue
+			}
+			for v := 0; v < numVertices; v++ {
+				if ap.dist[u][v] > ap.dist[u][k]+ap.dist[k][v] {
+					ap.dist[u][v] = ap.dist[u][k] + ap.dist[k][v]
+					ap.edgeTo[u][v] = ap.edgeTo[k][v]
+				}
+			}
+			// Stop as soon as a negative cycle is found, as the distances would otherwise
+			// keep decreasing without bound.
+			if ap.dist[u][u] < 0 {
+				return nil, ap.negativeCycle(u)
+			}
+		}
+	}
+	return ap, nil
+}
+
+// negativeCycle returns the negative cycle through which u can reach itself.
+// The edges leading to each vertex on the shortest paths from u contain the cycle.
+//
+// The cycle is always found. An edge to v is recorded whenever dist[u][v] is lowered, so every v
+// with hasEdgeTo[v] has an edge to it. Its tail t is reachable from u, and so either t != u and
+// hasEdgeTo[t], or t == u and hasEdgeTo[u] as dist[u][u] < 0. Following the edges backwards from u
+// therefore never runs out of edges, and has to come back to a vertex seen before.
+func (ap AllPairsShortestPaths) negativeCycle(u int) error {
+	var sp = &ShortestPaths{
+		source: u,
+		distTo: ap.dist[u],
+		edgeTo: ap.edgeTo[u],
+	}
+	var hasEdgeTo = make([]bool, len(ap.dist))
+	for v := range hasEdgeTo {
+		hasEdgeTo[v] = ap.HasPath(u, v) && ((v != u) || (ap.dist[u][u] < 0))
+	}
+	cycle, _ := findCycle(sp, hasEdgeTo)
+	return cycle
+}
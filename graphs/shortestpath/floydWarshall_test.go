@@ -0,0 +1,144 @@
+package shortestpath
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/directed"
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+// allPairsFunc computes all-pairs shortest paths.
+type allPairsFunc func(graphs.WeightedGraph) (*AllPairsShortestPaths, error)
+
+// testAllPairsAgainstSingleSource checks that the all-pairs result matches that of
+// running BellmanFord from every vertex.
+func testAllPairsAgainstSingleSource(t *testing.T, wg graphs.WeightedDigraph, ap *AllPairsShortestPaths) {
+	for u := 0; u < wg.GetV(); u++ {
+		sp, err := BellmanFord(wg, u)
+		assert.NoError(t, err)
+		for v := 0; v < wg.GetV(); v++ {
+			assert.Equal(t, sp.HasPathTo(v), ap.HasPath(u, v))
+			expectedDist, _ := sp.DistTo(v)
+			dist, validVertices := ap.Dist(u, v)
+			assert.True(t, validVertices)
+			if math.IsInf(expectedDist, 1) {
+				assert.True(t, math.IsInf(dist, 1))
+				continue
+			}
+			assert.InDelta(t, expectedDist, dist, 1e-9)
+
+			// The path should be a valid path whose length is the distance.
+			path, found := ap.Path(u, v)
+			assert.True(t, found)
+			assert.Equal(t, u, path[0])
+			assert.Equal(t, v, path[len(path)-1])
+			assert.InDelta(t, dist, pathLength(wg, path), 1e-9)
+		}
+	}
+}
+
+// pathLength returns the length of the given path using the lightest of any parallel edges.
+// Returns +Inf if the path is not valid.
+func pathLength(wg graphs.WeightedGraph, path []int) float64 {
+	var length = 0.0
+	for i := 0; i+1 < len(path); i++ {
+		var lightest = math.Inf(1)
+		adjEdges, _ := wg.AdjacentEdges(path[i])
+		for _, e := range adjEdges {
+			if other, _ := e.Other(path[i]); (other == path[i+1]) && (e.Weight() < lightest) {
+				lightest = e.Weight()
+			}
+		}
+		length += lightest
+	}
+	return length
+}
+
+// testNegativeCycle checks that the error holds a valid negative cycle.
+func testNegativeCycle(t *testing.T, wg graphs.WeightedGraph, err error) {
+	assert.Error(t, err)
+	cycleErr, ok := err.(*NegativeCycleError)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.True(t, len(cycleErr.Cycle) >= 2)
+	assert.Equal(t, cycleErr.Cycle[0], cycleErr.Cycle[len(cycleErr.Cycle)-1])
+	assert.Less(t, cycleErr.Weight, 0.0)
+	assert.False(t, math.IsInf(pathLength(wg, cycleErr.Cycle), 1))
+}
+
+func testAllPairs(t *testing.T, allPairs allPairsFunc) {
+	wg := getWeightedDirectedGraph(t)
+	ap, err := allPairs(wg)
+	assert.NoError(t, err)
+	testAllPairsAgainstSingleSource(t, wg, ap)
+
+	wg = getWeightedDirectedGraphWithNegativeWeights(t)
+	ap, err = allPairs(wg)
+	assert.NoError(t, err)
+	testAllPairsAgainstSingleSource(t, wg, ap)
+	dist, _ := ap.Dist(0, 1)
+	assert.InDelta(t, 0.93, dist, 1e-9)
+
+	// Invalid vertices.
+	_, validVertices := ap.Dist(0, 8)
+	assert.False(t, validVertices)
+	_, found := ap.Path(-1, 0)
+	assert.False(t, found)
+
+	// Unreachable vertices.
+	wg = directed.NewWeightedDirectedGraph(3)
+	wg.AddWeightedEdge(0, 1, 2.0)
+	ap, err = allPairs(wg)
+	assert.NoError(t, err)
+	assert.False(t, ap.HasPath(1, 0))
+	assert.False(t, ap.HasPath(0, 2))
+	path, found := ap.Path(2, 2)
+	assert.True(t, found)
+	assert.Equal(t, []int{2}, path)
+
+	// Undirected graphs.
+	ug := undirected.NewWeightedUndirectedGraph(3)
+	ug.AddWeightedEdge(1, 0, 1.0)
+	ug.AddWeightedEdge(2, 1, 1.0)
+	ug.AddWeightedEdge(0, 2, 3.0)
+	ap, err = allPairs(ug)
+	assert.NoError(t, err)
+	dist, _ = ap.Dist(2, 0)
+	assert.InDelta(t, 2.0, dist, 1e-9)
+	path, _ = ap.Path(2, 0)
+	assert.Equal(t, []int{2, 1, 0}, path)
+}
+
+func testAllPairsNegativeCycle(t *testing.T, allPairs allPairsFunc) {
+	wg := directed.NewWeightedDirectedGraph(8)
+	for _, e := range getWeightedDirectedGraph(t).Edges() {
+		if (e.From() == 5) && (e.To() == 4) {
+			wg.AddWeightedEdge(5, 4, -0.66)
+			continue
+		}
+		wg.AddWeightedEdge(e.From(), e.To(), e.Weight())
+	}
+	ap, err := allPairs(wg)
+	assert.Nil(t, ap)
+	testNegativeCycle(t, wg, err)
+
+	// A negative cycle that is not reachable from vertex 0 is still reported.
+	wg = directed.NewWeightedDirectedGraph(4)
+	wg.AddWeightedEdge(0, 1, 1.0)
+	wg.AddWeightedEdge(2, 3, 1.0)
+	wg.AddWeightedEdge(3, 2, -2.0)
+	_, err = allPairs(wg)
+	testNegativeCycle(t, wg, err)
+	assert.ElementsMatch(t, []int{2, 3}, err.(*NegativeCycleError).Cycle[1:])
+}
+
+func TestFloydWarshall(t *testing.T) {
+	testAllPairs(t, FloydWarshall)
+}
+
+func TestFloydWarshall_NegativeCycle(t *testing.T) {
+	testAllPairsNegativeCycle(t, FloydWarshall)
+}
@@ -0,0 +1,92 @@
+package shortestpath
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/directed"
+)
+
+// Johnson computes the shortest paths between every pair of vertices.
+//
+// Edges are allowed to have negative weights. If the graph contains a negative cycle, then a
+// *NegativeCycleError containing the cycle is returned. Running Dijkstra from every vertex makes
+// this faster than FloydWarshall for sparse graphs.
+//
+// A new vertex q is added with an edge of weight 0 to every other vertex, and BellmanFord is run
+// from q to find h(v), the length of the shortest path from q to v. Every edge u->v is then
+// reweighted to w(u, v) + h(u) - h(v), which is non-negative. The reweighting changes the length
+// of every path from u to v by the same amount, h(u) - h(v), so shortest paths are preserved and
+// Dijkstra can be run from every vertex.
+//
+// The reweighted edges are only used to find the shortest paths. The distances are then added up
+// from the original edges along the paths, so that rounding errors in h, and the reweighted edges
+// that had to be raised to 0 because of them, do not change the reported weights.
+func Johnson(g graphs.WeightedGraph) (*AllPairsShortestPaths, error) {
+	var numVertices = g.GetV()
+	var q = numVertices
+	var augmented = directed.NewWeightedDirectedGraph(numVertices + 1)
+	for u := 0; u < numVertices; u++ {
+		adjEdges, _ := g.AdjacentEdges(u)
+		for _, e := range adjEdges {
+			e = directedFrom(e, u)
+			augmented.AddWeightedEdge(e.From(), e.To(), e.Weight())
+		}
+		augmented.AddWeightedEdge(q, u, 0)
+	}
+
+	// q has no incoming edges and therefore cannot be on a negative cycle.
+	h, err := BellmanFord(augmented, q)
+	if err != nil {
+		return nil, err
+	}
+
+	var reweighted = directed.NewWeightedDirectedGraph(numVertices)
+	// original maps every reweighted edge to the edge it was created from. Parallel edges that end
+	// up identical after reweighting map to the lightest of them.
+	var original = make(map[graphs.Edge]graphs.Edge)
+	for _, e := range augmented.Edges() {
+		if e.From() == q {
+			continue
+		}
+		var weight = e.Weight() + h.distTo[e.From()] - h.distTo[e.To()]
+		// Guarding against floating point errors for edges on shortest paths, whose weight is 0.
+		if weight < 0 {
+			weight = 0
+		}
+		reweighted.AddWeightedEdge(e.From(), e.To(), weight)
+		var key = graphs.NewEdge(e.From(), e.To(), weight)
+		if o, ok := original[key]; !ok || (e.Weight() < o.Weight()) {
+			original[key] = e
+		}
+	}
+
+	var ap = newAllPairsShortestPaths(numVertices)
+	for u := 0; u < numVertices; u++ {
+		sp, err := Dijkstra(reweighted, u)
+		if err != nil {
+			return nil, err
+		}
+		// done[v] records whether the distance to v has been added up. The distance to u is 0, as
+		// there are no negative cycles.
+		var done = make([]bool, numVertices)
+		done[u] = true
+		for v := 0; v < numVertices; v++ {
+			if !sp.HasPathTo(v) {
+				continue
+			}
+			// Following the edges back from v to a vertex whose distance is known, and then adding
+			// up the original weights on the way back to v.
+			var pending = make([]int, 0, 0)
+			for w := v; !done[w]; w = sp.edgeTo[w].From() {
+				pending = append(pending, w)
+			}
+			for i := len(pending) - 1; i >= 0; i-- {
+				w := pending[i]
+				e := original[sp.edgeTo[w]]
+				ap.edgeTo[u][w] = e
+				ap.dist[u][w] = ap.dist[u][e.From()] + e.Weight()
+				done[w] = true
+			}
+		}
+	}
+	return ap, nil
+}
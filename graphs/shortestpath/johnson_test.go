@@ -0,0 +1,80 @@
+package shortestpath
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/directed"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestJohnson(t *testing.T) {
+	testAllPairs(t, Johnson)
+}
+
+func TestJohnson_NegativeCycle(t *testing.T) {
+	testAllPairsNegativeCycle(t, Johnson)
+}
+
+func TestJohnson_AgreesWithFloydWarshall(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		wg := directed.NewWeightedDirectedGraph(10)
+		for j := 0; j < 25; j++ {
+			wg.AddWeightedEdge(r.Intn(10), r.Intn(10), r.Float64()*10-2)
+		}
+
+		fw, fwErr := FloydWarshall(wg)
+		johnson, johnsonErr := Johnson(wg)
+		if fwErr != nil {
+			testNegativeCycle(t, wg, fwErr)
+			testNegativeCycle(t, wg, johnsonErr)
+			continue
+		}
+		assert.NoError(t, johnsonErr)
+		testAllPairsAgainstSingleSource(t, wg, fw)
+		testAllPairsAgainstSingleSource(t, wg, johnson)
+	}
+}
+
+func TestJohnson_OriginalWeights(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		wg := directed.NewWeightedDirectedGraph(10)
+		var weights = make(map[graphs.Edge]struct{})
+		for j := 0; j < 25; j++ {
+			// A few small negative weights, so that h is not 0 and the reweighted edges are
+			// rounded. Graphs with negative cycles are skipped.
+			u, v, weight := r.Intn(10), r.Intn(10), r.Float64()*0.3
+			if r.Intn(4) == 0 {
+				weight = -weight / 7
+			}
+			wg.AddWeightedEdge(u, v, weight)
+			weights[graphs.NewEdge(u, v, weight)] = struct{}{}
+		}
+		ap, err := Johnson(wg)
+		if err != nil {
+			continue
+		}
+
+		// Every edge on the paths is one of the edges of the graph, and the distances are the
+		// exact sums of their weights.
+		for u := 0; u < wg.GetV(); u++ {
+			for v := 0; v < wg.GetV(); v++ {
+				path, found := ap.Path(u, v)
+				if !found || (u == v) {
+					continue
+				}
+				var length = 0.0
+				for k := 1; k < len(path); k++ {
+					e := ap.edgeTo[u][path[k]]
+					_, ok := weights[e]
+					assert.True(t, ok, "edge %v is not in the graph", e)
+					length += e.Weight()
+				}
+				dist, _ := ap.Dist(u, v)
+				assert.Equal(t, length, dist)
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package shortestpath
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"math"
+)
+
+// ShortestPaths is the result of a single-source shortest paths computation.
+//
+// distTo[v] is the length of the shortest known path from the source to v.
+// edgeTo[v] is the last edge on the shortest known path from the source to v, directed towards v.
+// Vertices that are not reachable from the source have distTo = +Inf.
+type ShortestPaths struct {
+	source int
+	distTo []float64
+	edgeTo []graphs.Edge
+}
+
+func newShortestPaths(v int, source int) *ShortestPaths {
+	sp := &ShortestPaths{
+		source: source,
+		distTo: make([]float64, v),
+		edgeTo: make([]graphs.Edge, v),
+	}
+	for i := 0; i < v; i++ {
+		sp.distTo[i] = math.Inf(1)
+	}
+	sp.distTo[source] = 0
+	return sp
+}
+
+// Source returns the vertex from which the shortest paths were computed.
+func (sp ShortestPaths) Source() int {
+	return sp.source
+}
+
+func (sp ShortestPaths) isValid(v int) bool {
+	return (v >= 0) && (v < len(sp.distTo))
+}
+
+// DistTo returns the length of the shortest path from the source to the given vertex.
+// The length is +Inf if the vertex is not reachable from the source.
+// Return false if vertex does not exist.
+func (sp ShortestPaths) DistTo(v int) (float64, bool) {
+	if !sp.isValid(v) {
+		return math.Inf(1), false
+	}
+	return sp.distTo[v], true
+}
+
+// HasPathTo returns whether there is a path from the source to the given vertex.
+func (sp ShortestPaths) HasPathTo(v int) bool {
+	return sp.isValid(v) && !math.IsInf(sp.distTo[v], 1)
+}
+
+// EdgeTo returns the last edge on the shortest path from the source to the given vertex.
+// Return false if there is no such edge, which is the case for the source itself
+// and for vertices that are not reachable from the source.
+func (sp ShortestPaths) EdgeTo(v int) (graphs.Edge, bool) {
+	if !sp.HasPathTo(v) || (v == sp.source) {
+		return graphs.Edge{}, false
+	}
+	return sp.edgeTo[v], true
+}
+
+// PathTo returns the vertices on the shortest path from the source to the given vertex.
+//
+// The edges on the path are traced back from the destination to the source.
+func (sp ShortestPaths) PathTo(dest int) ([]int, bool) {
+	if !sp.HasPathTo(dest) {
+		return []int{}, false
+	}
+
+	var path = []int{dest}
+	for v := dest; v != sp.source; v = sp.edgeTo[v].From() {
+		path = append([]int{sp.edgeTo[v].From()}, path...)
+	}
+	return path, true
+}
+
+// relax relaxes the edge e that is incident on the vertex from.
+// Returns the other endpoint of the edge and whether the edge was relaxed.
+func (sp *ShortestPaths) relax(e graphs.Edge, from int) (int, bool) {
+	e = directedFrom(e, from)
+	to := e.To()
+	if sp.distTo[to] > sp.distTo[from]+e.Weight() {
+		sp.distTo[to] = sp.distTo[from] + e.Weight()
+		sp.edgeTo[to] = e
+		return to, true
+	}
+	return to, false
+}
+
+// directedFrom returns the edge e, that is incident on the vertex v, directed away from v.
+// Edges of undirected graphs are stored only once and could be directed towards v.
+func directedFrom(e graphs.Edge, v int) graphs.Edge {
+	if e.From() != v {
+		return e.Reverse()
+	}
+	return e
+}
@@ -0,0 +1,152 @@
+package graphs
+
+import (
+	"bufio"
+	"github.com/pkg/errors"
+	"io"
+	"strings"
+)
+
+// SymbolGraph is a graph whose vertices are named using strings.
+// API taken from https://algs4.cs.princeton.edu/41graph/.
+//
+// Every name is assigned an index from 0 to V-1 in the order in which it first appears in the input.
+// The underlying graph uses the indices as vertices, and the results of the graph based algorithms
+// are translated back to names.
+type SymbolGraph struct {
+	index map[string]int
+	names []string
+	g     Graph
+}
+
+// NewSymbolGraph reads a symbol graph where every line holds names separated by the delimiter.
+// The first name on a line is connected to each of the other names on the line, so a line with two
+// names is a single edge. Spaces around names are ignored, as are empty lines.
+// The graph is created using newGraph(V), and so can be either directed or undirected.
+// Returns error if a line has an empty name.
+func NewSymbolGraph(r io.Reader, delimiter string, newGraph func(int) Graph) (*SymbolGraph, error) {
+	var sg = &SymbolGraph{
+		index: make(map[string]int),
+		names: make([]string, 0, 0),
+	}
+
+	// The number of vertices is only known once all the names have been read.
+	var lines = make([][]string, 0, 0)
+	var scanner = bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var names = strings.Split(scanner.Text(), delimiter)
+		for i, name := range names {
+			names[i] = strings.TrimSpace(name)
+			if names[i] == "" {
+				return nil, errors.Errorf("line %d: empty name", lineNum)
+			}
+			if _, ok := sg.index[names[i]]; !ok {
+				sg.index[names[i]] = len(sg.names)
+				sg.names = append(sg.names, names[i])
+			}
+		}
+		lines = append(lines, names)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read symbol graph")
+	}
+
+	sg.g = newGraph(len(sg.names))
+	for _, names := range lines {
+		v := sg.index[names[0]]
+		for _, name := range names[1:] {
+			sg.g.AddEdge(v, sg.index[name])
+		}
+	}
+	return sg, nil
+}
+
+// Graph returns the underlying graph, whose vertices are the indices of the names.
+func (sg SymbolGraph) Graph() Graph {
+	return sg.g
+}
+
+// Contains returns whether there is a vertex with the given name.
+func (sg SymbolGraph) Contains(name string) bool {
+	_, ok := sg.index[name]
+	return ok
+}
+
+// Index returns the vertex with the given name.
+// Return false if there is no such vertex.
+func (sg SymbolGraph) Index(name string) (int, bool) {
+	v, ok := sg.index[name]
+	if !ok {
+		return -1, false
+	}
+	return v, true
+}
+
+// Name returns the name of the given vertex.
+// Return false if vertex does not exist.
+func (sg SymbolGraph) Name(v int) (string, bool) {
+	if (v < 0) || (v >= len(sg.names)) {
+		return "", false
+	}
+	return sg.names[v], true
+}
+
+// namesOf translates the vertices to their names.
+func (sg SymbolGraph) namesOf(vertices []int) []string {
+	var names = make([]string, len(vertices))
+	for i, v := range vertices {
+		names[i] = sg.names[v]
+	}
+	return names
+}
+
+// Adjacent returns the names of the vertices adjacent to the one with the given name.
+// Return false if there is no such vertex.
+func (sg SymbolGraph) Adjacent(name string) ([]string, bool) {
+	v, ok := sg.index[name]
+	if !ok {
+		return []string{}, false
+	}
+	adjList, _ := sg.g.Adjacent(v)
+	return sg.namesOf(adjList), true
+}
+
+// Degree returns the degree of the vertex with the given name.
+// Return false if there is no such vertex.
+func (sg SymbolGraph) Degree(name string) (int, bool) {
+	v, ok := sg.index[name]
+	if !ok {
+		return -1, false
+	}
+	return sg.g.Degree(v)
+}
+
+// FindPath finds a path between the vertices with the given names using the FindPath of the
+// underlying graph, and returns the names of the vertices on it.
+// Return false if either of the names does not exist or if there is no path.
+func (sg SymbolGraph) FindPath(source, dest string) ([]string, bool) {
+	s, okSource := sg.index[source]
+	d, okDest := sg.index[dest]
+	if !okSource || !okDest {
+		return []string{}, false
+	}
+	path, found := sg.g.FindPath(s, d)
+	if !found {
+		return []string{}, false
+	}
+	return sg.namesOf(path), true
+}
+
+// FindConnectedComponents returns the names of the vertices in each of the connected components
+// found by the underlying graph.
+func (sg SymbolGraph) FindConnectedComponents() [][]string {
+	var components = sg.g.FindConnectedComponents()
+	var names = make([][]string, len(components))
+	for i, component := range components {
+		names[i] = sg.namesOf(component)
+	}
+	return names
+}
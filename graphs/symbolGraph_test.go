@@ -0,0 +1,122 @@
+package graphs_test
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/graphs/directed"
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+// routes is routes.txt from https://algs4.cs.princeton.edu/41graph/.
+const routes = `JFK MCO
+ORD DEN
+ORD HOU
+DFW PHX
+JFK ATL
+ORD DFW
+ORD PHX
+ATL HOU
+DEN PHX
+PHX LAX
+JFK ORD
+DEN LAS
+DFW HOU
+ORD ATL
+LAS LAX
+ATL MCO
+HOU MCO
+LAS PHX
+`
+
+func newUndirectedGraph(v int) graphs.Graph {
+	return undirected.NewUndirectedGraph(v)
+}
+
+func TestNewSymbolGraph(t *testing.T) {
+	sg, err := graphs.NewSymbolGraph(strings.NewReader(routes), " ", newUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, sg.Graph().GetV())
+
+	assert.True(t, sg.Contains("JFK"))
+	assert.False(t, sg.Contains("SFO"))
+	v, ok := sg.Index("JFK")
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+	v, ok = sg.Index("LAS")
+	assert.True(t, ok)
+	assert.Equal(t, 9, v)
+	_, ok = sg.Index("SFO")
+	assert.False(t, ok)
+	name, ok := sg.Name(2)
+	assert.True(t, ok)
+	assert.Equal(t, "ORD", name)
+	_, ok = sg.Name(10)
+	assert.False(t, ok)
+}
+
+func TestSymbolGraph_Adjacent(t *testing.T) {
+	sg, err := graphs.NewSymbolGraph(strings.NewReader(routes), " ", newUndirectedGraph)
+	assert.NoError(t, err)
+	adjacent, ok := sg.Adjacent("JFK")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"ORD", "ATL", "MCO"}, adjacent)
+	adjacent, ok = sg.Adjacent("LAX")
+	assert.True(t, ok)
+	assert.ElementsMatch(t, []string{"LAS", "PHX"}, adjacent)
+	deg, ok := sg.Degree("ORD")
+	assert.True(t, ok)
+	assert.Equal(t, 6, deg)
+
+	_, ok = sg.Adjacent("SFO")
+	assert.False(t, ok)
+	_, ok = sg.Degree("SFO")
+	assert.False(t, ok)
+}
+
+func TestSymbolGraph_FindPath(t *testing.T) {
+	sg, err := graphs.NewSymbolGraph(strings.NewReader(routes), " ", newUndirectedGraph)
+	assert.NoError(t, err)
+	path, found := sg.FindPath("JFK", "LAS")
+	assert.True(t, found)
+	assert.Equal(t, "JFK", path[0])
+	assert.Equal(t, "LAS", path[len(path)-1])
+	for i := 0; i+1 < len(path); i++ {
+		adjacent, _ := sg.Adjacent(path[i])
+		assert.Contains(t, adjacent, path[i+1])
+	}
+
+	_, found = sg.FindPath("JFK", "SFO")
+	assert.False(t, found)
+}
+
+func TestSymbolGraph_FindConnectedComponents(t *testing.T) {
+	var input = `Bacon, Kevin/Animal House/Diner
+Guinness, Alec/Star Wars
+
+Ford, Harrison/Star Wars/Witness
+`
+	sg, err := graphs.NewSymbolGraph(strings.NewReader(input), "/", newUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, sg.Graph().GetV())
+	var components = sg.FindConnectedComponents()
+	assert.Len(t, components, 2)
+	assert.ElementsMatch(t, []string{"Bacon, Kevin", "Animal House", "Diner"}, components[0])
+	assert.ElementsMatch(t, []string{"Guinness, Alec", "Star Wars", "Ford, Harrison", "Witness"}, components[1])
+
+	_, err = graphs.NewSymbolGraph(strings.NewReader("a//b\n"), "/", newUndirectedGraph)
+	assert.Error(t, err)
+}
+
+func TestSymbolGraph_Directed(t *testing.T) {
+	sg, err := graphs.NewSymbolGraph(strings.NewReader("a b\nb c\n"), " ", func(v int) graphs.Graph {
+		return directed.NewDirectedGraph(v)
+	})
+	assert.NoError(t, err)
+	path, found := sg.FindPath("a", "c")
+	assert.True(t, found)
+	assert.Equal(t, []string{"a", "b", "c"}, path)
+	_, found = sg.FindPath("c", "a")
+	assert.False(t, found)
+}
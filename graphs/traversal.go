@@ -0,0 +1,236 @@
+package graphs
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/queue"
+	"github.com/pradykaushik/data-structures/queue/fifo"
+	"github.com/pradykaushik/data-structures/stack"
+)
+
+// vertex is a vertex stored in the queues used by the traversals.
+type vertex int
+
+func (v vertex) Get() interface{} {
+	return int(v)
+}
+
+// Traversal runs the traversals in the Graph API using only GetV and the adjacent vertices, so
+// that they are shared by all the graph representations. The depth first traversals are written on
+// top of Walker.
+//
+// The traversals that take a context stop once it is cancelled, and return ctx.Err() along with
+// the result computed so far. They are used to implement CancellableGraph.
+type Traversal struct {
+	g        Graph
+	adjacent func(int) []int
+}
+
+// NewTraversal returns a Traversal over the graph, exploring the vertices given by g.Adjacent.
+func NewTraversal(g Graph) *Traversal {
+	return NewTraversalFunc(g, func(v int) []int {
+		adjList, _ := g.Adjacent(v)
+		return adjList
+	})
+}
+
+// NewTraversalFunc is like NewTraversal, but the vertices explored from v are given by adjacent(v),
+// as with NewWalkerFunc.
+func NewTraversalFunc(g Graph, adjacent func(v int) []int) *Traversal {
+	return &Traversal{
+		g:        g,
+		adjacent: adjacent,
+	}
+}
+
+// isValid returns whether the given vertex exists in the graph.
+func (t Traversal) isValid(v int) bool {
+	return (v >= 0) && (v < t.g.GetV())
+}
+
+// newWalker returns a Walker that has not visited any vertex yet.
+func (t Traversal) newWalker() *Walker {
+	return NewWalkerFunc(t.g, t.adjacent)
+}
+
+// Dfs returns the vertices in dfs order, starting a new walk from every vertex not yet visited.
+func (t Traversal) Dfs(ctx context.Context) ([]int, error) {
+	var walker = t.newWalker()
+	var result = make([]int, 0, 0)
+	for v := 0; v < t.g.GetV(); v++ {
+		if err := dfs(ctx, walker, v, &result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// dfs appends the vertices visited in a walk from v to the result.
+// Nothing is appended if v has already been visited by the walker.
+func dfs(ctx context.Context, walker *Walker, v int, result *[]int) error {
+	_, err := walker.WalkContext(ctx, v, Visitor{
+		PreVisit: func(w int) WalkSignal {
+			(*result) = append(*result, w)
+			return Continue
+		},
+	})
+	return err
+}
+
+// Bfs returns the vertices in bfs order, starting a new search from every vertex not yet visited.
+func (t Traversal) Bfs(ctx context.Context) ([]int, error) {
+	var nextV = fifo.NewLinearQueueArr(t.g.GetV())
+	var visited = make([]bool, t.g.GetV())
+	var result = make([]int, 0, 0)
+
+	for i := range visited {
+		if !visited[i] {
+			nextV.Enqueue(vertex(i))
+			visited[i] = true // marking visited.
+			if err := t.bfs(ctx, nextV, visited, &result); err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, nil
+}
+
+func (t Traversal) bfs(
+	ctx context.Context,
+	next queue.Queue,
+	visited []bool,
+	result *[]int) error {
+
+	for !next.IsEmpty() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		nextV, _ := next.Dequeue()
+		v := nextV.Get().(int)
+		(*result) = append(*result, v)
+		for _, adjV := range t.adjacent(v) {
+			if !visited[adjV] {
+				next.Enqueue(vertex(adjV))
+				visited[adjV] = true // marking visited.
+			}
+		}
+	}
+	return nil
+}
+
+// ConnectedVertices returns the vertices visited in a dfs from the source.
+// Returns error if the source does not exist.
+func (t Traversal) ConnectedVertices(ctx context.Context, source int) ([]int, error) {
+	if !t.isValid(source) {
+		return []int{}, errors.Errorf("vertex %d does not exist", source)
+	}
+
+	var connected = make([]int, 0, 0)
+	err := dfs(ctx, t.newWalker(), source, &connected)
+	return connected, err
+}
+
+// FindPath finds a path from source vertex to destination vertex.
+// Returns error if either of the vertices does not exist.
+//
+// When traversing the graph, store the parent vertices after each hop.
+// The parents are then traced back from the destination to the source.
+func (t Traversal) FindPath(ctx context.Context, source, dest int) ([]int, bool, error) {
+	if !t.isValid(source) {
+		return []int{}, false, errors.Errorf("vertex %d does not exist", source)
+	}
+	if !t.isValid(dest) {
+		return []int{}, false, errors.Errorf("vertex %d does not exist", dest)
+	}
+	if source == dest {
+		return []int{source}, true, nil
+	}
+
+	var found = false
+	var parentTracker = make([]int, t.g.GetV())
+	_, err := t.newWalker().WalkContext(ctx, source, Visitor{
+		TreeEdge: func(v, w int) WalkSignal {
+			parentTracker[w] = v
+			return Continue
+		},
+		PreVisit: func(v int) WalkSignal {
+			found = v == dest
+			if found {
+				return Stop
+			}
+			return Continue
+		},
+	})
+	var path = make([]int, 0, 0)
+	if found {
+		// Tracing the parents back from the destination, and then reversing.
+		for i := dest; i != source; i = parentTracker[i] {
+			path = append(path, i)
+		}
+		path = append(path, source)
+		for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+			path[i], path[j] = path[j], path[i]
+		}
+	}
+	return path, found, err
+}
+
+// FindPathV2 finds a path from source vertex to destination vertex.
+// Return false if either of the vertices does not exist.
+//
+// Every vertex is pushed onto the path when it is visited, and popped once all its adjacent
+// vertices have been explored without reaching the destination.
+func (t Traversal) FindPathV2(source, dest int) ([]int, bool) {
+	if !t.isValid(source) || !t.isValid(dest) {
+		return []int{}, false
+	}
+	if source == dest {
+		return []int{source}, true
+	}
+
+	// Using a stack for constant time pop() operation.
+	// Could also use doubly linkedlist (might actually be more efficient).
+	var path = stack.NewArrayStack(t.g.GetV())
+	var found = false
+	t.newWalker().Walk(source, Visitor{
+		PreVisit: func(v int) WalkSignal {
+			path.Push(v)
+			// we have found the path.
+			found = v == dest
+			if found {
+				return Stop
+			}
+			return Continue
+		},
+		PostVisit: func(int) WalkSignal {
+			// none of the explorations from this vertex were fruitful.
+			path.Pop()
+			return Continue
+		},
+	})
+
+	var pathArr = make([]int, path.Size())
+	for i := len(pathArr) - 1; i >= 0; i-- {
+		pathArr[i], _ = path.Pop()
+	}
+	return pathArr, found
+}
+
+// ConnectedComponents returns the vertices visited in each of the walks started from the vertices
+// that are not yet visited. These are the connected components if every edge can be explored from
+// both its endpoints.
+func (t Traversal) ConnectedComponents(ctx context.Context) ([][]int, error) {
+	var walker = t.newWalker()
+	var connectedComponents = make([][]int, 0, 0)
+	for i := 0; i < t.g.GetV(); i++ {
+		if walker.Visited(i) {
+			continue
+		}
+		var connected = make([]int, 0, 0)
+		if err := dfs(ctx, walker, i, &connected); err != nil {
+			return connectedComponents, err
+		}
+		connectedComponents = append(connectedComponents, connected)
+	}
+	return connectedComponents, nil
+}
@@ -0,0 +1,250 @@
+package undirected
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/pradykaushik/data-structures/graphs"
+	"math/bits"
+)
+
+// AdjacencyMatrixGraph is an undirected Graph stored as an adjacency matrix, where every row is a
+// bitset of the vertices adjacent to the vertex.
+// Checking for an edge takes constant time, and the matrix takes V^2 bits irrespective of the
+// number of edges, which makes it a good fit for dense graphs.
+//
+// As there is a single bit for every pair of vertices, the graph cannot have parallel edges.
+// A self-loop is allowed and, as in UndirectedGraph, counts twice towards the degree of the vertex.
+type AdjacencyMatrixGraph struct {
+	rows        [][]uint64
+	degree      []int
+	numVertices int
+	numEdges    int
+}
+
+// numWords returns the number of words needed for a row with one bit for each of the v vertices.
+func numWords(v int) int {
+	return (v + 63) / 64
+}
+
+// NewAdjacencyMatrixGraph creates an undirected graph, represented by an adjacency matrix, with the
+// provided number of vertices and no edges.
+func NewAdjacencyMatrixGraph(v int) graphs.Graph {
+	g := &AdjacencyMatrixGraph{
+		rows:        make([][]uint64, v),
+		degree:      make([]int, v),
+		numVertices: v,
+		numEdges:    0,
+	}
+
+	for i := 0; i < g.numVertices; i++ {
+		g.rows[i] = make([]uint64, numWords(v))
+	}
+
+	return g
+}
+
+func (g AdjacencyMatrixGraph) GetV() int {
+	return g.numVertices
+}
+
+func (g AdjacencyMatrixGraph) GetE() int {
+	return g.numEdges
+}
+
+// isValid returns whether the given vertex exists in the graph.
+func (g AdjacencyMatrixGraph) isValid(v int) bool {
+	return (v >= 0) && (v < g.numVertices)
+}
+
+func (g AdjacencyMatrixGraph) hasBit(v1, v2 int) bool {
+	return g.rows[v1][v2/64]&(1<<uint(v2%64)) != 0
+}
+
+func (g *AdjacencyMatrixGraph) setBit(v1, v2 int) {
+	g.rows[v1][v2/64] |= 1 << uint(v2%64)
+}
+
+func (g *AdjacencyMatrixGraph) clearBit(v1, v2 int) {
+	g.rows[v1][v2/64] &^= 1 << uint(v2%64)
+}
+
+// AddEdge adds the edge v1-v2.
+// Return false if either vertex does not exist or if the edge is already present, as parallel
+// edges cannot be represented.
+func (g *AdjacencyMatrixGraph) AddEdge(v1 int, v2 int) bool {
+	if !g.isValid(v1) || !g.isValid(v2) || g.hasBit(v1, v2) {
+		return false
+	}
+
+	g.setBit(v1, v2)
+	g.setBit(v2, v1)
+	g.degree[v1]++
+	g.degree[v2]++
+	g.numEdges++
+	return true
+}
+
+func (g AdjacencyMatrixGraph) HasEdge(v1 int, v2 int) bool {
+	if !g.isValid(v1) || !g.isValid(v2) {
+		return false
+	}
+	return g.hasBit(v1, v2)
+}
+
+func (g *AdjacencyMatrixGraph) RemoveEdge(v1 int, v2 int) bool {
+	if !g.HasEdge(v1, v2) {
+		return false
+	}
+
+	g.clearBit(v1, v2)
+	g.clearBit(v2, v1)
+	g.degree[v1]--
+	g.degree[v2]--
+	g.numEdges--
+	return true
+}
+
+// AddVertex adds a row for the new vertex. The existing rows only need to grow when they run
+// out of bits.
+func (g *AdjacencyMatrixGraph) AddVertex() int {
+	g.numVertices++
+	var words = numWords(g.numVertices)
+	if words > numWords(g.numVertices-1) {
+		for v := range g.rows {
+			g.rows[v] = append(g.rows[v], 0)
+		}
+	}
+	g.rows = append(g.rows, make([]uint64, words))
+	g.degree = append(g.degree, 0)
+	return g.numVertices - 1
+}
+
+// RemoveVertex removes the vertex and its edges.
+// Renumbering the remaining vertices shifts the columns of every row, so the rows are copied into a
+// new matrix without the row and the column of the vertex - O(V^2).
+func (g *AdjacencyMatrixGraph) RemoveVertex(v int) bool {
+	if !g.isValid(v) {
+		return false
+	}
+
+	var result = NewAdjacencyMatrixGraph(g.numVertices - 1).(*AdjacencyMatrixGraph)
+	for x := range g.rows {
+		if x == v {
+			continue
+		}
+		newX := renumber(x, v)
+		result.degree[newX] = g.degree[x]
+		if g.hasBit(x, v) {
+			result.degree[newX]--
+		}
+		for i, word := range g.rows[x] {
+			for word != 0 {
+				if w := i*64 + bits.TrailingZeros64(word); w != v {
+					result.setBit(newX, renumber(w, v))
+				}
+				// clearing the lowest set bit.
+				word &= word - 1
+			}
+		}
+	}
+	// A self-loop on v is a single edge that counts twice towards its degree.
+	result.numEdges = g.numEdges - g.degree[v]
+	if g.hasBit(v, v) {
+		result.numEdges++
+	}
+	*g = *result
+	return true
+}
+
+// renumber returns the new number of vertex w once the vertex removed is gone.
+func renumber(w, removed int) int {
+	if w > removed {
+		return w - 1
+	}
+	return w
+}
+
+// Adjacent returns the vertices adjacent to v in increasing order.
+// As in UndirectedGraph, a self-loop on v results in v being present twice, so that the number of
+// adjacent vertices is the degree.
+func (g AdjacencyMatrixGraph) Adjacent(v int) ([]int, bool) {
+	var adjVertices []int
+	if !g.isValid(v) {
+		return adjVertices, false
+	}
+
+	adjVertices = make([]int, 0, g.degree[v])
+	for i, word := range g.rows[v] {
+		for word != 0 {
+			w := i*64 + bits.TrailingZeros64(word)
+			adjVertices = append(adjVertices, w)
+			if w == v {
+				adjVertices = append(adjVertices, w)
+			}
+			// clearing the lowest set bit.
+			word &= word - 1
+		}
+	}
+	return adjVertices, true
+}
+
+func (g AdjacencyMatrixGraph) Degree(v int) (int, bool) {
+	if !g.isValid(v) {
+		return -1, false
+	}
+	return g.degree[v], true
+}
+
+func (g AdjacencyMatrixGraph) InDegree(v int) (int, bool) {
+	return g.Degree(v)
+}
+
+func (g AdjacencyMatrixGraph) OutDegree(v int) (int, bool) {
+	return g.Degree(v)
+}
+
+func (g AdjacencyMatrixGraph) String() string {
+	var buf = new(bytes.Buffer)
+	for v := range g.rows {
+		buf.WriteString(fmt.Sprintf("%d => ", v))
+		adjVertices, _ := g.Adjacent(v)
+		buf.WriteString(fmt.Sprintf("%v\n", adjVertices))
+	}
+	return buf.String()
+}
+
+func (g AdjacencyMatrixGraph) Dfs() []int {
+	result, _ := newTraversal(&g).Dfs(context.Background())
+	return result
+}
+
+func (g AdjacencyMatrixGraph) Bfs() []int {
+	result, _ := newTraversal(&g).Bfs(context.Background())
+	return result
+}
+
+// Walk runs a depth first walk from start, making the callbacks in visitor.
+// Return false if vertex does not exist.
+func (g AdjacencyMatrixGraph) Walk(start int, visitor graphs.Visitor) bool {
+	return walk(&g, start, visitor)
+}
+
+func (g AdjacencyMatrixGraph) ConnectedVertices(source int) ([]int, bool) {
+	connected, err := newTraversal(&g).ConnectedVertices(context.Background(), source)
+	return connected, err == nil
+}
+
+func (g AdjacencyMatrixGraph) FindPath(source, dest int) ([]int, bool) {
+	path, found, _ := newTraversal(&g).FindPath(context.Background(), source, dest)
+	return path, found
+}
+
+func (g AdjacencyMatrixGraph) FindPathV2(source, dest int) ([]int, bool) {
+	return newTraversal(&g).FindPathV2(source, dest)
+}
+
+func (g AdjacencyMatrixGraph) FindConnectedComponents() [][]int {
+	connectedComponents, _ := newTraversal(&g).ConnectedComponents(context.Background())
+	return connectedComponents
+}
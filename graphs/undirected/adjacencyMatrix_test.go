@@ -0,0 +1,132 @@
+package undirected
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func getAdjacencyMatrixGraph(t *testing.T) graphs.Graph {
+	am := NewAdjacencyMatrixGraph(13)
+	assert.NotNil(t, am)
+	for _, e := range tinyGEdges {
+		assert.True(t, am.AddEdge(e[0], e[1]))
+	}
+	return am
+}
+
+// testSameEdges checks that both the graphs have the same edges, ignoring the order of the
+// adjacent vertices.
+func testSameEdges(t *testing.T, expected, actual graphs.Graph) {
+	assert.Equal(t, expected.GetV(), actual.GetV())
+	assert.Equal(t, expected.GetE(), actual.GetE())
+	for v := 0; v < expected.GetV(); v++ {
+		expectedDegree, _ := expected.Degree(v)
+		degree, ok := actual.Degree(v)
+		assert.True(t, ok)
+		assert.Equal(t, expectedDegree, degree)
+		for w := 0; w < expected.GetV(); w++ {
+			assert.Equal(t, expected.HasEdge(v, w), actual.HasEdge(v, w))
+		}
+	}
+}
+
+// sortedComponents sorts the vertices within each component, and the components by their first
+// vertex.
+func sortedComponents(components [][]int) [][]int {
+	for _, c := range components {
+		sort.Ints(c)
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i][0] < components[j][0]
+	})
+	return components
+}
+
+func TestAdjacencyMatrixGraph(t *testing.T) {
+	ug := getUndirectedGraph(t)
+	am := getAdjacencyMatrixGraph(t)
+	testSameEdges(t, ug, am)
+
+	adjList, ok := am.Adjacent(0)
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 2, 5, 6}, adjList)
+	_, ok = am.Adjacent(13)
+	assert.False(t, ok)
+	assert.Equal(t, []int{0, 1, 2, 5, 3, 4, 6, 7, 8, 9, 10, 11, 12}, am.Dfs())
+	assert.Equal(t, []int{0, 1, 2, 5, 6, 3, 4, 7, 8, 9, 10, 11, 12}, am.Bfs())
+	assert.Equal(t, sortedComponents(ug.FindConnectedComponents()),
+		sortedComponents(am.FindConnectedComponents()))
+
+	path, found := am.FindPath(0, 3)
+	assert.True(t, found)
+	assert.Equal(t, []int{0, 5, 3}, path)
+	pathV2, found := am.FindPathV2(0, 3)
+	assert.True(t, found)
+	assert.Equal(t, path, pathV2)
+	_, found = am.FindPath(0, 9)
+	assert.False(t, found)
+
+	// Parallel edges cannot be represented.
+	assert.False(t, am.AddEdge(5, 0))
+	assert.False(t, am.AddEdge(0, 13))
+	assert.Equal(t, 13, am.GetE())
+}
+
+func TestAdjacencyMatrixGraph_SelfLoop(t *testing.T) {
+	am := NewAdjacencyMatrixGraph(3)
+	assert.True(t, am.AddEdge(1, 1))
+	assert.True(t, am.AddEdge(1, 2))
+	assert.True(t, am.HasEdge(1, 1))
+	degree, _ := am.Degree(1)
+	assert.Equal(t, 3, degree)
+	adjList, _ := am.Adjacent(1)
+	// The self-loop is listed twice, so that the number of adjacent vertices is the degree.
+	assert.Equal(t, []int{1, 1, 2}, adjList)
+	assert.Equal(t, degree, len(adjList))
+	assert.Equal(t, 2, am.GetE())
+
+	assert.True(t, am.RemoveEdge(1, 1))
+	assert.False(t, am.HasEdge(1, 1))
+	degree, _ = am.Degree(1)
+	assert.Equal(t, 1, degree)
+	adjList, _ = am.Adjacent(1)
+	assert.Equal(t, []int{2}, adjList)
+	assert.Equal(t, 1, am.GetE())
+	assert.False(t, am.RemoveEdge(1, 1))
+
+	// Removing a vertex with a self-loop.
+	assert.True(t, am.AddEdge(2, 2))
+	assert.True(t, am.RemoveVertex(2))
+	assert.Equal(t, 2, am.GetV())
+	assert.Equal(t, 0, am.GetE())
+	degree, _ = am.Degree(1)
+	assert.Equal(t, 0, degree)
+}
+
+func TestAdjacencyMatrixGraph_AddRemoveVertex(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	// Crossing the boundary of a word when adding vertices.
+	ug := NewUndirectedGraph(60)
+	am := NewAdjacencyMatrixGraph(60)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, ug.AddVertex(), am.AddVertex())
+	}
+	for i := 0; i < 300; i++ {
+		v, w := r.Intn(70), r.Intn(70)
+		if !ug.HasEdge(v, w) {
+			ug.AddEdge(v, w)
+			assert.True(t, am.AddEdge(v, w))
+		}
+	}
+	testSameEdges(t, ug, am)
+
+	for _, v := range []int{69, 0, 30, 64} {
+		assert.True(t, ug.RemoveVertex(v))
+		assert.True(t, am.RemoveVertex(v))
+		testSameEdges(t, ug, am)
+	}
+	assert.False(t, am.RemoveVertex(66))
+}
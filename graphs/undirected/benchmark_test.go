@@ -0,0 +1,96 @@
+package undirected
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"math/rand"
+	"testing"
+)
+
+// randomEdges returns e random edges between v vertices.
+func randomEdges(v, e int) [][2]int {
+	r := rand.New(rand.NewSource(42))
+	var edges = make([][2]int, e)
+	for i := range edges {
+		edges[i] = [2]int{r.Intn(v), r.Intn(v)}
+	}
+	return edges
+}
+
+// Sparse graph with a million vertices, used to compare traversals.
+const sparseV, sparseE = 1 << 20, 1 << 21
+
+// Dense graph, used to compare edge lookups.
+const denseV, denseE = 2000, 1000000
+
+func newUndirectedGraphFromEdges(v int, edges [][2]int) graphs.Graph {
+	ug := NewUndirectedGraph(v)
+	for _, e := range edges {
+		ug.AddEdge(e[0], e[1])
+	}
+	return ug
+}
+
+func newAdjacencyMatrixGraphFromEdges(v int, edges [][2]int) graphs.Graph {
+	am := NewAdjacencyMatrixGraph(v)
+	for _, e := range edges {
+		am.AddEdge(e[0], e[1])
+	}
+	return am
+}
+
+func newCSRGraphFromEdges(v int, edges [][2]int) graphs.Graph {
+	csr, _ := NewCSRGraph(v, edges)
+	return csr
+}
+
+func benchmarkDfs(b *testing.B, g graphs.Graph) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.Dfs()
+	}
+}
+
+func BenchmarkUndirectedGraph_Dfs(b *testing.B) {
+	benchmarkDfs(b, newUndirectedGraphFromEdges(sparseV, randomEdges(sparseV, sparseE)))
+}
+
+func BenchmarkCSRGraph_Dfs(b *testing.B) {
+	benchmarkDfs(b, newCSRGraphFromEdges(sparseV, randomEdges(sparseV, sparseE)))
+}
+
+func benchmarkAdjacent(b *testing.B, g graphs.Graph) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for v := 0; v < g.GetV(); v++ {
+			g.Adjacent(v)
+		}
+	}
+}
+
+func BenchmarkUndirectedGraph_Adjacent(b *testing.B) {
+	benchmarkAdjacent(b, newUndirectedGraphFromEdges(sparseV, randomEdges(sparseV, sparseE)))
+}
+
+func BenchmarkCSRGraph_Adjacent(b *testing.B) {
+	benchmarkAdjacent(b, newCSRGraphFromEdges(sparseV, randomEdges(sparseV, sparseE)))
+}
+
+func benchmarkHasEdge(b *testing.B, g graphs.Graph) {
+	r := rand.New(rand.NewSource(7))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.HasEdge(r.Intn(denseV), r.Intn(denseV))
+	}
+}
+
+func BenchmarkUndirectedGraph_HasEdge(b *testing.B) {
+	benchmarkHasEdge(b, newUndirectedGraphFromEdges(denseV, randomEdges(denseV, denseE)))
+}
+
+func BenchmarkAdjacencyMatrixGraph_HasEdge(b *testing.B) {
+	benchmarkHasEdge(b, newAdjacencyMatrixGraphFromEdges(denseV, randomEdges(denseV, denseE)))
+}
+
+func BenchmarkCSRGraph_HasEdge(b *testing.B) {
+	benchmarkHasEdge(b, newCSRGraphFromEdges(denseV, randomEdges(denseV, denseE)))
+}
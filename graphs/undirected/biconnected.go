@@ -0,0 +1,125 @@
+package undirected
+
+import "github.com/pradykaushik/data-structures/graphs"
+
+// Bridges returns the edges whose removal would disconnect their endpoints.
+// Parallel edges are never bridges, and self-loops are ignored.
+func (g UndirectedGraph) Bridges() []graphs.Pair {
+	return g.lowLink().bridges
+}
+
+// ArticulationPoints returns, in increasing order, the vertices whose removal would
+// increase the number of connected components.
+func (g UndirectedGraph) ArticulationPoints() []int {
+	var ll = g.lowLink()
+	var points = make([]int, 0, 0)
+	for v, isArticulation := range ll.articulation {
+		if isArticulation {
+			points = append(points, v)
+		}
+	}
+	return points
+}
+
+// BiconnectedComponents returns the edges in each of the biconnected components of the graph.
+// A biconnected component is a maximal set of edges such that any two of them lie on a common
+// simple cycle. Every edge, other than a self-loop, belongs to exactly one component, and a bridge
+// is a component by itself. Self-loops are ignored.
+func (g UndirectedGraph) BiconnectedComponents() [][]graphs.Pair {
+	return g.lowLink().components
+}
+
+// lowLinkResult holds the results of a single low-link dfs.
+type lowLinkResult struct {
+	bridges      []graphs.Pair
+	articulation []bool
+	components   [][]graphs.Pair
+}
+
+// lowLink runs a dfs assigning every vertex v its preorder number pre[v] and low[v], the smallest
+// preorder number reachable from the subtree rooted at v using at most one back edge.
+//
+// For a tree edge p-v, once v has been explored:
+//   - if low[v] > pre[p], then the subtree of v cannot reach p or above without p-v, so p-v is a bridge.
+//   - if low[v] >= pre[p], then removing p disconnects the subtree of v, so p is an articulation point
+//     unless it is the root. The root is an articulation point if it has more than one child.
+//     The edges explored since p-v then form a biconnected component.
+func (g UndirectedGraph) lowLink() *lowLinkResult {
+	var result = &lowLinkResult{
+		bridges:      make([]graphs.Pair, 0, 0),
+		articulation: make([]bool, len(g.gph)),
+		components:   make([][]graphs.Pair, 0, 0),
+	}
+	var pre = make([]int, len(g.gph))
+	var low = make([]int, len(g.gph))
+	var parent = make([]int, len(g.gph))
+	var preCounter = 0
+	for v := range parent {
+		parent[v] = -1
+	}
+	var edgeStack = make([]graphs.Pair, 0, 0)
+	var rootChildren = 0
+
+	graphs.WalkAll(&g, graphs.Visitor{
+		PreVisit: func(v int) graphs.WalkSignal {
+			pre[v] = preCounter
+			low[v] = preCounter
+			preCounter++
+			if parent[v] == -1 {
+				rootChildren = 0
+			}
+			return graphs.Continue
+		},
+		TreeEdge: func(v, w int) graphs.WalkSignal {
+			parent[w] = v
+			edgeStack = append(edgeStack, graphs.NewPair(v, w))
+			if parent[v] == -1 {
+				rootChildren++
+			}
+			return graphs.Continue
+		},
+		BackEdge: func(v, w int) graphs.WalkSignal {
+			// Self-loops are ignored.
+			if v == w {
+				return graphs.Continue
+			}
+			edgeStack = append(edgeStack, graphs.NewPair(v, w))
+			if pre[w] < low[v] {
+				low[v] = pre[w]
+			}
+			return graphs.Continue
+		},
+		PostVisit: func(v int) graphs.WalkSignal {
+			p := parent[v]
+			if p == -1 {
+				if rootChildren > 1 {
+					result.articulation[v] = true
+				}
+				return graphs.Continue
+			}
+			if low[v] < low[p] {
+				low[p] = low[v]
+			}
+			if low[v] > pre[p] {
+				result.bridges = append(result.bridges, graphs.NewPair(p, v))
+			}
+			if low[v] >= pre[p] {
+				if parent[p] != -1 {
+					result.articulation[p] = true
+				}
+				var component = make([]graphs.Pair, 0, 0)
+				for {
+					e := edgeStack[len(edgeStack)-1]
+					edgeStack = edgeStack[:len(edgeStack)-1]
+					component = append(component, e)
+					if e == graphs.NewPair(p, v) {
+						break
+					}
+				}
+				result.components = append(result.components, component)
+			}
+			return graphs.Continue
+		},
+	})
+	return result
+}
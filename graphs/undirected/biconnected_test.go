@@ -0,0 +1,129 @@
+package undirected
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+// numComponentsWithout returns the number of connected components after removing the
+// given vertex (if not -1) and the edge at index skipEdge of pairs (if not -1).
+// The removed vertex is not counted as a component.
+func numComponentsWithout(v int, pairs [][]int, skipVertex, skipEdge int) int {
+	ug := NewUndirectedGraph(v)
+	for i, p := range pairs {
+		if (i == skipEdge) || (p[0] == skipVertex) || (p[1] == skipVertex) {
+			continue
+		}
+		ug.AddEdge(p[0], p[1])
+	}
+	if skipVertex != -1 {
+		return len(ug.FindConnectedComponents()) - 1
+	}
+	return len(ug.FindConnectedComponents())
+}
+
+func sortedPair(v, w int) [2]int {
+	if v > w {
+		return [2]int{w, v}
+	}
+	return [2]int{v, w}
+}
+
+// testLowLink checks bridges and articulation points against removing every edge and vertex,
+// and checks that the biconnected components partition the edges.
+func testLowLink(t *testing.T, v int, pairs [][]int) {
+	ug := NewUndirectedGraph(v).(*UndirectedGraph)
+	for _, p := range pairs {
+		ug.AddEdge(p[0], p[1])
+	}
+	var components = numComponentsWithout(v, pairs, -1, -1)
+
+	var expectedBridges [][2]int
+	var nonLoopEdges = 0
+	for i, p := range pairs {
+		if p[0] == p[1] {
+			continue
+		}
+		nonLoopEdges++
+		if numComponentsWithout(v, pairs, -1, i) > components {
+			expectedBridges = append(expectedBridges, sortedPair(p[0], p[1]))
+		}
+	}
+	var bridges [][2]int
+	for _, e := range ug.Bridges() {
+		bridges = append(bridges, sortedPair(e.From(), e.To()))
+	}
+	assert.ElementsMatch(t, expectedBridges, bridges)
+
+	var expectedPoints = make([]int, 0, 0)
+	for x := 0; x < v; x++ {
+		if numComponentsWithout(v, pairs, x, -1) > components {
+			expectedPoints = append(expectedPoints, x)
+		}
+	}
+	assert.Equal(t, expectedPoints, ug.ArticulationPoints())
+
+	var numEdges = 0
+	for _, component := range ug.BiconnectedComponents() {
+		assert.NotEmpty(t, component)
+		numEdges += len(component)
+	}
+	assert.Equal(t, nonLoopEdges, numEdges)
+}
+
+func TestUndirectedGraph_LowLink(t *testing.T) {
+	// The graph from https://algs4.cs.princeton.edu/41graph/Biconnected.java.html.
+	testLowLink(t, 13, [][]int{
+		{0, 1}, {0, 2}, {0, 6}, {0, 5}, {5, 3}, {5, 4}, {3, 4}, {4, 6}, {7, 8},
+		{9, 10}, {9, 11}, {9, 12}, {11, 12},
+	})
+	// tinyG.txt.
+	testLowLink(t, 13, [][]int{
+		{0, 5}, {4, 3}, {0, 1}, {9, 12}, {6, 4}, {5, 4}, {0, 2}, {11, 12}, {9, 10},
+		{0, 6}, {7, 8}, {9, 11}, {5, 3},
+	})
+	// Parallel edges and self-loops.
+	testLowLink(t, 4, [][]int{{0, 1}, {1, 0}, {1, 2}, {2, 2}, {2, 3}})
+}
+
+func TestUndirectedGraph_LowLinkRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		var pairs [][]int
+		for j := 0; j < 12; j++ {
+			pairs = append(pairs, []int{r.Intn(10), r.Intn(10)})
+		}
+		testLowLink(t, 10, pairs)
+	}
+}
+
+func TestUndirectedGraph_BiconnectedComponents(t *testing.T) {
+	// Two triangles sharing vertex 2, and a bridge 4-5.
+	ug := NewUndirectedGraph(6).(*UndirectedGraph)
+	for _, p := range [][]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 2}, {4, 5}} {
+		ug.AddEdge(p[0], p[1])
+	}
+	assert.Equal(t, []int{2, 4}, ug.ArticulationPoints())
+	bridges := ug.Bridges()
+	assert.Len(t, bridges, 1)
+	assert.Equal(t, [2]int{4, 5}, sortedPair(bridges[0].From(), bridges[0].To()))
+
+	var sizes []int
+	for _, component := range ug.BiconnectedComponents() {
+		sizes = append(sizes, len(component))
+	}
+	assert.ElementsMatch(t, []int{3, 3, 1}, sizes)
+}
+
+func TestUndirectedGraph_LowLinkLongPath(t *testing.T) {
+	// Every edge of a path is a bridge and every internal vertex is an articulation point.
+	const n = 200000
+	ug := NewUndirectedGraph(n).(*UndirectedGraph)
+	for v := 0; v+1 < n; v++ {
+		ug.AddEdge(v, v+1)
+	}
+	assert.Len(t, ug.Bridges(), n-1)
+	assert.Len(t, ug.ArticulationPoints(), n-2)
+	assert.Len(t, ug.BiconnectedComponents(), n-1)
+}
@@ -0,0 +1,87 @@
+package undirected
+
+import "github.com/pradykaushik/data-structures/graphs"
+
+// Bipartition is the result of checking whether an undirected graph is bipartite.
+// A graph is bipartite if its vertices can be colored using two colors such that the two
+// endpoints of every edge have different colors. A graph is bipartite if and only if it
+// does not contain a cycle of odd length.
+//
+// If the graph is bipartite, then color holds the two-coloring.
+// Otherwise, oddCycle holds a cycle of odd length as a certificate.
+type Bipartition struct {
+	isBipartite bool
+	color       []bool
+	oddCycle    []int
+}
+
+// IsBipartite returns whether the graph is bipartite.
+func (b Bipartition) IsBipartite() bool {
+	return b.isBipartite
+}
+
+// Color returns the side of the bipartition that the given vertex belongs to.
+// Return false if the graph is not bipartite or if the vertex does not exist.
+func (b Bipartition) Color(v int) (bool, bool) {
+	if !b.isBipartite || (v < 0) || (v >= len(b.color)) {
+		return false, false
+	}
+	return b.color[v], true
+}
+
+// Coloring returns the color of every vertex, or an empty coloring if the graph is not bipartite.
+// Vertices with the same color are on the same side of the bipartition.
+func (b Bipartition) Coloring() []bool {
+	if !b.isBipartite {
+		return []bool{}
+	}
+	return b.color
+}
+
+// OddCycle returns a cycle of odd length, or an empty cycle if the graph is bipartite.
+// The first and the last vertices of the cycle are the same.
+func (b Bipartition) OddCycle() []int {
+	return b.oddCycle
+}
+
+// Bipartition checks whether the graph is bipartite.
+//
+// Every connected component is colored using a dfs, where every vertex gets the opposite color
+// of its parent. An undirected dfs only has tree edges and back edges. If a back edge v-w has
+// endpoints of the same color, then the path from the ancestor w down to v in the dfs tree has an
+// even number of edges, and together with the edge v-w forms a cycle of odd length.
+func (g UndirectedGraph) Bipartition() *Bipartition {
+	var b = &Bipartition{
+		isBipartite: true,
+		color:       make([]bool, len(g.gph)),
+		oddCycle:    make([]int, 0, 0),
+	}
+	var parentTracker = make([]int, len(g.gph))
+
+	graphs.WalkAll(&g, graphs.Visitor{
+		TreeEdge: func(v, w int) graphs.WalkSignal {
+			parentTracker[w] = v
+			b.color[w] = !b.color[v]
+			return graphs.Continue
+		},
+		BackEdge: func(v, w int) graphs.WalkSignal {
+			if b.color[w] != b.color[v] {
+				return graphs.Continue
+			}
+			b.isBipartite = false
+			b.oddCycle = oddCycle(v, w, parentTracker)
+			return graphs.Stop
+		},
+	})
+	return b
+}
+
+// oddCycle returns the cycle formed by the back edge v-w and the path from v back to its ancestor w.
+// A self-loop on v is returned as [v v].
+func oddCycle(v, w int, parentTracker []int) []int {
+	var cycle = make([]int, 0, 0)
+	for x := v; x != w; x = parentTracker[x] {
+		cycle = append(cycle, x)
+	}
+	return append(cycle, w, v)
+}
@@ -0,0 +1,68 @@
+package undirected
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestUndirectedGraph_Bipartition(t *testing.T) {
+	// An even cycle and a tree.
+	ug := NewUndirectedGraph(8).(*UndirectedGraph)
+	ug.AddEdge(0, 1)
+	ug.AddEdge(1, 2)
+	ug.AddEdge(2, 3)
+	ug.AddEdge(3, 0)
+	ug.AddEdge(4, 5)
+	ug.AddEdge(4, 6)
+	ug.AddEdge(6, 7)
+
+	b := ug.Bipartition()
+	assert.True(t, b.IsBipartite())
+	assert.Empty(t, b.OddCycle())
+	coloring := b.Coloring()
+	assert.Len(t, coloring, 8)
+	for v := 0; v < 8; v++ {
+		color, ok := b.Color(v)
+		assert.True(t, ok)
+		assert.Equal(t, coloring[v], color)
+		adjL, _ := ug.Adjacent(v)
+		for _, w := range adjL {
+			assert.NotEqual(t, coloring[v], coloring[w])
+		}
+	}
+	_, ok := b.Color(8)
+	assert.False(t, ok)
+}
+
+func TestUndirectedGraph_BipartitionOddCycle(t *testing.T) {
+	// tinyG.txt contains the triangle 3-4-5.
+	ug := getUndirectedGraph(t).(*UndirectedGraph)
+	b := ug.Bipartition()
+	assert.False(t, b.IsBipartite())
+	assert.Empty(t, b.Coloring())
+	_, ok := b.Color(0)
+	assert.False(t, ok)
+
+	cycle := b.OddCycle()
+	testCycle(t, ug, cycle)
+	// The number of edges on the cycle should be odd.
+	assert.Equal(t, 1, (len(cycle)-1)%2)
+
+	// A cycle of length 5 reached through a path.
+	ug = NewUndirectedGraph(7).(*UndirectedGraph)
+	for _, p := range [][]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 2}} {
+		ug.AddEdge(p[0], p[1])
+	}
+	cycle = ug.Bipartition().OddCycle()
+	assert.Len(t, cycle, 6)
+	testCycle(t, ug, cycle)
+	assert.ElementsMatch(t, []int{2, 3, 4, 5, 6}, cycle[:5])
+
+	// Self-loop.
+	ug = NewUndirectedGraph(2).(*UndirectedGraph)
+	ug.AddEdge(0, 1)
+	ug.AddEdge(1, 1)
+	b = ug.Bipartition()
+	assert.False(t, b.IsBipartite())
+	assert.Equal(t, []int{1, 1}, b.OddCycle())
+}
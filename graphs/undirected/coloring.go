@@ -0,0 +1,424 @@
+package undirected
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/heap"
+	"sort"
+	"time"
+)
+
+// Coloring assigns a color to every vertex such that the two endpoints of every edge have
+// different colors. The colors are numbered from 0 to NumColors()-1.
+type Coloring struct {
+	color     []int
+	numColors int
+}
+
+// newColoring returns the coloring for the given colors, where every vertex has been colored.
+func newColoring(color []int) *Coloring {
+	var c = &Coloring{color: color, numColors: 0}
+	for _, col := range color {
+		if col+1 > c.numColors {
+			c.numColors = col + 1
+		}
+	}
+	return c
+}
+
+// NumColors returns the number of colors used.
+func (c Coloring) NumColors() int {
+	return c.numColors
+}
+
+// Color returns the color of the given vertex.
+// Return false if vertex does not exist.
+func (c Coloring) Color(v int) (int, bool) {
+	if (v < 0) || (v >= len(c.color)) {
+		return -1, false
+	}
+	return c.color[v], true
+}
+
+// Colors returns the color of every vertex.
+func (c Coloring) Colors() []int {
+	return c.color
+}
+
+// ColorClasses returns the vertices of each color, where the vertices of color i are in increasing
+// order at index i. Every color class is an independent set.
+func (c Coloring) ColorClasses() [][]int {
+	var classes = make([][]int, c.numColors)
+	for v, col := range c.color {
+		classes[col] = append(classes[col], v)
+	}
+	return classes
+}
+
+// neighbours returns the distinct vertices adjacent to every vertex.
+// Returns error if the graph has a self-loop, as its vertex cannot have a different color from itself.
+func (g UndirectedGraph) neighbours() ([][]int, error) {
+	var adjLists = make([][]int, len(g.gph))
+	var seen = make([]int, len(g.gph))
+	for v := range seen {
+		seen[v] = -1
+	}
+	for v := range g.gph {
+		adjList, _ := g.Adjacent(v)
+		for _, w := range adjList {
+			if w == v {
+				return nil, errors.Errorf("vertex %d has a self-loop and cannot be colored", v)
+			}
+			// Skipping parallel edges.
+			if seen[w] != v {
+				seen[w] = v
+				adjLists[v] = append(adjLists[v], w)
+			}
+		}
+	}
+	return adjLists, nil
+}
+
+// greedyColoring colors the vertices in the given order, giving every vertex the smallest color not
+// used by its neighbours that have already been colored.
+func greedyColoring(adjLists [][]int, order []int) *Coloring {
+	var color = make([]int, len(adjLists))
+	for v := range color {
+		color[v] = -1
+	}
+	// usedBy[c] == v marks color c as used by a neighbour of v.
+	var usedBy = make([]int, len(adjLists)+1)
+	for c := range usedBy {
+		usedBy[c] = -1
+	}
+	for _, v := range order {
+		for _, w := range adjLists[v] {
+			if color[w] != -1 {
+				usedBy[color[w]] = v
+			}
+		}
+		var c = 0
+		for usedBy[c] == v {
+			c++
+		}
+		color[v] = c
+	}
+	return newColoring(color)
+}
+
+// WelshPowellColoring colors the graph greedily, going over the vertices in decreasing order
+// of degree.
+// Returns error if the graph has a self-loop.
+//
+// Coloring the vertices with the most constraints first tends to use fewer colors. If d is the
+// largest degree, at most d+1 colors are used.
+func (g UndirectedGraph) WelshPowellColoring() (*Coloring, error) {
+	adjLists, err := g.neighbours()
+	if err != nil {
+		return nil, err
+	}
+
+	var order = make([]int, len(adjLists))
+	for v := range order {
+		order[v] = v
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(adjLists[order[i]]) > len(adjLists[order[j]])
+	})
+	return greedyColoring(adjLists, order), nil
+}
+
+// SmallestLastColoring colors the graph greedily, going over the vertices in the smallest-last order.
+// Returns error if the graph has a self-loop.
+//
+// The vertex of smallest degree is repeatedly removed from the graph, and the vertices are colored
+// in the reverse order of removal. Every vertex then has at most k neighbours colored before it,
+// where k is the largest of the smallest degrees seen during the removals (the degeneracy).
+// Therefore, at most k+1 colors are used, and planar graphs are colored with at most 6 colors.
+//
+// The vertices are kept in buckets by their degree in the remaining graph. As the degree of a vertex
+// changes, it is added to the new bucket and the stale entry is skipped later on - O(V + E).
+func (g UndirectedGraph) SmallestLastColoring() (*Coloring, error) {
+	adjLists, err := g.neighbours()
+	if err != nil {
+		return nil, err
+	}
+
+	var n = len(adjLists)
+	var degree = make([]int, n)
+	var buckets = make([][]int, n)
+	for v := range adjLists {
+		degree[v] = len(adjLists[v])
+		buckets[degree[v]] = append(buckets[degree[v]], v)
+	}
+	var removed = make([]bool, n)
+	var order = make([]int, n)
+	var minDegree = 0
+	for i := n - 1; i >= 0; i-- {
+		var v = -1
+		for v == -1 {
+			for len(buckets[minDegree]) == 0 {
+				minDegree++
+			}
+			last := len(buckets[minDegree]) - 1
+			w := buckets[minDegree][last]
+			buckets[minDegree] = buckets[minDegree][:last]
+			if !removed[w] && (degree[w] == minDegree) {
+				v = w
+			}
+		}
+		removed[v] = true
+		order[i] = v
+		for _, w := range adjLists[v] {
+			if !removed[w] {
+				degree[w]--
+				buckets[degree[w]] = append(buckets[degree[w]], w)
+			}
+		}
+		// Removing a vertex lowers the degree of its neighbours by at most 1.
+		if minDegree > 0 {
+			minDegree--
+		}
+	}
+	return greedyColoring(adjLists, order), nil
+}
+
+// DSaturColoring colors the graph using the DSatur heuristic of Brélaz.
+// Returns error if the graph has a self-loop.
+//
+// The next vertex to be colored is the one whose neighbours already use the most distinct colors
+// (its saturation), with ties broken by degree. It is given the smallest color not used by its
+// neighbours. DSatur colors bipartite graphs with at most 2 colors.
+//
+// The uncolored vertices are kept in an indexed priority queue, keyed by their negated saturation
+// and degree so that the minimum is the next vertex to be colored.
+func (g UndirectedGraph) DSaturColoring() (*Coloring, error) {
+	adjLists, err := g.neighbours()
+	if err != nil {
+		return nil, err
+	}
+
+	var n = len(adjLists)
+	var color = make([]int, n)
+	var saturation = make([]int, n)
+	// neighbourColors[v] holds the distinct colors of the neighbours of v.
+	var neighbourColors = make([]map[int]struct{}, n)
+	var key = func(v int) float64 {
+		return -(float64(saturation[v])*float64(n+1) + float64(len(adjLists[v])))
+	}
+	var pq = heap.NewIndexMinPQ(n)
+	for v := 0; v < n; v++ {
+		color[v] = -1
+		neighbourColors[v] = make(map[int]struct{})
+		pq.Insert(v, key(v))
+	}
+
+	for !pq.IsEmpty() {
+		v, _ := pq.DeleteMin()
+		var c = 0
+		for {
+			if _, ok := neighbourColors[v][c]; !ok {
+				break
+			}
+			c++
+		}
+		color[v] = c
+		for _, w := range adjLists[v] {
+			if color[w] != -1 {
+				continue
+			}
+			if _, ok := neighbourColors[w][c]; !ok {
+				neighbourColors[w][c] = struct{}{}
+				saturation[w]++
+				pq.ChangeKey(w, key(w))
+			}
+		}
+	}
+	return newColoring(color), nil
+}
+
+// greedyClique returns a clique found by starting from every vertex and adding the neighbours, in
+// decreasing order of degree, that are adjacent to all the vertices in the clique so far.
+// The size of any clique is a lower bound on the number of colors needed.
+func greedyClique(adjLists [][]int) []int {
+	var adjacent = make([]map[int]struct{}, len(adjLists))
+	for v := range adjLists {
+		adjacent[v] = make(map[int]struct{}, len(adjLists[v]))
+		for _, w := range adjLists[v] {
+			adjacent[v][w] = struct{}{}
+		}
+	}
+
+	var best = make([]int, 0, 0)
+	for v := range adjLists {
+		// A clique containing v cannot be larger than deg(v)+1.
+		if len(adjLists[v])+1 <= len(best) {
+			continue
+		}
+		var candidates = append([]int{}, adjLists[v]...)
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return len(adjLists[candidates[i]]) > len(adjLists[candidates[j]])
+		})
+		var clique = []int{v}
+		for _, w := range candidates {
+			var inClique = true
+			for _, x := range clique {
+				if _, ok := adjacent[w][x]; !ok {
+					inClique = false
+					break
+				}
+			}
+			if inClique {
+				clique = append(clique, w)
+			}
+		}
+		if len(clique) > len(best) {
+			best = clique
+		}
+	}
+	return best
+}
+
+// ChromaticNumberBounds returns a lower and an upper bound on the chromatic number, i.e., the
+// smallest number of colors needed to color the graph.
+// Returns error if the graph has a self-loop.
+//
+// The lower bound is the size of a clique found greedily, and the upper bound is the fewest colors
+// used by the Welsh-Powell, smallest-last and DSatur colorings.
+func (g UndirectedGraph) ChromaticNumberBounds() (int, int, error) {
+	adjLists, err := g.neighbours()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var upper = len(adjLists)
+	for _, colorer := range []func() (*Coloring, error){
+		g.WelshPowellColoring, g.SmallestLastColoring, g.DSaturColoring} {
+		c, _ := colorer()
+		if c.NumColors() < upper {
+			upper = c.NumColors()
+		}
+	}
+	return len(greedyClique(adjLists)), upper, nil
+}
+
+// exactColorer holds the state of the backtracking search for an optimal coloring.
+type exactColorer struct {
+	adjLists [][]int
+	color    []int
+	// numNeighbours[v][c] is the number of neighbours of v with color c.
+	numNeighbours [][]int
+	saturation    []int
+	best          []int
+	numBest       int
+	lowerBound    int
+	deadline      time.Time
+	timedOut      bool
+}
+
+// ExactColoring finds a coloring using the fewest colors, by backtracking over the colorings.
+// As the search takes exponential time in the worst case, it is meant for small graphs and stops
+// once the budget runs out. The fewest-color coloring found so far is then returned, along with
+// false to indicate that it might not be optimal.
+// Returns error if the graph has a self-loop.
+//
+// The search starts from the DSatur coloring, and only looks for colorings using fewer colors than
+// the best one found so far. Vertices are colored in DSatur order, trying the colors already used
+// and then a single new one, as the new colors are interchangeable. The search stops early once a
+// coloring uses as many colors as the size of a clique, as no coloring could use fewer.
+// The depth of the recursion is at most V.
+func (g UndirectedGraph) ExactColoring(budget time.Duration) (*Coloring, bool, error) {
+	adjLists, err := g.neighbours()
+	if err != nil {
+		return nil, false, err
+	}
+	dsatur, _ := g.DSaturColoring()
+
+	var n = len(adjLists)
+	var ec = &exactColorer{
+		adjLists:      adjLists,
+		color:         make([]int, n),
+		numNeighbours: make([][]int, n),
+		saturation:    make([]int, n),
+		best:          dsatur.Colors(),
+		numBest:       dsatur.NumColors(),
+		lowerBound:    len(greedyClique(adjLists)),
+		deadline:      time.Now().Add(budget),
+		timedOut:      false,
+	}
+	for v := range ec.color {
+		ec.color[v] = -1
+		ec.numNeighbours[v] = make([]int, ec.numBest)
+	}
+	if ec.numBest > ec.lowerBound {
+		ec.search(0, 0)
+	}
+	return newColoring(ec.best), !ec.timedOut, nil
+}
+
+// search colors the remaining vertices, given that numColored vertices have been colored using
+// numUsed colors.
+func (ec *exactColorer) search(numColored, numUsed int) {
+	if time.Now().After(ec.deadline) {
+		ec.timedOut = true
+		return
+	}
+	if numColored == len(ec.color) {
+		ec.best = append([]int{}, ec.color...)
+		ec.numBest = numUsed
+		return
+	}
+
+	// The uncolored vertex with the largest saturation, with ties broken by degree.
+	var v = -1
+	for w := range ec.color {
+		if ec.color[w] != -1 {
+			continue
+		}
+		if (v == -1) || (ec.saturation[w] > ec.saturation[v]) ||
+			((ec.saturation[w] == ec.saturation[v]) && (len(ec.adjLists[w]) > len(ec.adjLists[v]))) {
+			v = w
+		}
+	}
+
+	// Colors 0..numUsed-1 are the ones in use, and numUsed is a new one.
+	for c := 0; c <= numUsed; c++ {
+		var used = numUsed
+		if c == numUsed {
+			used++
+		}
+		// Only colorings using fewer colors than the best one are of interest.
+		if used >= ec.numBest {
+			break
+		}
+		if ec.numNeighbours[v][c] > 0 {
+			continue
+		}
+		ec.setColor(v, c)
+		ec.search(numColored+1, used)
+		ec.unsetColor(v)
+		if ec.timedOut || (ec.numBest == ec.lowerBound) {
+			return
+		}
+	}
+}
+
+func (ec *exactColorer) setColor(v, c int) {
+	ec.color[v] = c
+	for _, w := range ec.adjLists[v] {
+		if ec.numNeighbours[w][c] == 0 {
+			ec.saturation[w]++
+		}
+		ec.numNeighbours[w][c]++
+	}
+}
+
+func (ec *exactColorer) unsetColor(v int) {
+	c := ec.color[v]
+	ec.color[v] = -1
+	for _, w := range ec.adjLists[v] {
+		ec.numNeighbours[w][c]--
+		if ec.numNeighbours[w][c] == 0 {
+			ec.saturation[w]--
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package undirected
+
+import (
+	"github.com/pradykaushik/data-structures/graphs/generate"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func getGraph(v int, pairs [][]int) *UndirectedGraph {
+	ug := NewUndirectedGraph(v).(*UndirectedGraph)
+	for _, p := range pairs {
+		ug.AddEdge(p[0], p[1])
+	}
+	return ug
+}
+
+// getGrotzschGraph returns the Grötzsch graph, which has no triangles but needs 4 colors.
+func getGrotzschGraph() *UndirectedGraph {
+	return getGraph(11, [][]int{
+		// Outer 5-cycle.
+		{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0},
+		// Every inner vertex 5+i is adjacent to the neighbours of i on the cycle.
+		{5, 1}, {5, 4}, {6, 0}, {6, 2}, {7, 1}, {7, 3}, {8, 2}, {8, 4}, {9, 3}, {9, 0},
+		// Hub adjacent to all the inner vertices.
+		{10, 5}, {10, 6}, {10, 7}, {10, 8}, {10, 9},
+	})
+}
+
+// testValidColoring checks that the endpoints of every edge have different colors.
+func testValidColoring(t *testing.T, ug *UndirectedGraph, c *Coloring) {
+	assert.Len(t, c.Colors(), ug.GetV())
+	for v := 0; v < ug.GetV(); v++ {
+		color, ok := c.Color(v)
+		assert.True(t, ok)
+		assert.True(t, (color >= 0) && (color < c.NumColors()))
+		adjList, _ := ug.Adjacent(v)
+		for _, w := range adjList {
+			assert.NotEqual(t, c.Colors()[w], color, "edge %d-%d", v, w)
+		}
+	}
+	var numVertices = 0
+	for _, class := range c.ColorClasses() {
+		assert.NotEmpty(t, class)
+		numVertices += len(class)
+	}
+	assert.Equal(t, ug.GetV(), numVertices)
+}
+
+// testColorings checks that all the colorings are valid, and returns the number of colors used
+// by the exact coloring.
+func testColorings(t *testing.T, ug *UndirectedGraph) int {
+	var heuristics = []func() (*Coloring, error){
+		ug.WelshPowellColoring, ug.SmallestLastColoring, ug.DSaturColoring}
+	for _, colorer := range heuristics {
+		c, err := colorer()
+		assert.NoError(t, err)
+		testValidColoring(t, ug, c)
+	}
+
+	exact, optimal, err := ug.ExactColoring(time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, optimal)
+	testValidColoring(t, ug, exact)
+
+	lower, upper, err := ug.ChromaticNumberBounds()
+	assert.NoError(t, err)
+	assert.True(t, lower <= exact.NumColors())
+	assert.True(t, exact.NumColors() <= upper)
+	return exact.NumColors()
+}
+
+func TestUndirectedGraph_Coloring(t *testing.T) {
+	// tinyG has the triangle 3-4-5.
+	assert.Equal(t, 3, testColorings(t, getUndirectedGraph(t).(*UndirectedGraph)))
+	// Bipartite.
+	assert.Equal(t, 2, testColorings(t, getGraph(6, [][]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 0}})))
+	// Odd cycle.
+	assert.Equal(t, 3, testColorings(t, getGraph(5, [][]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0}})))
+	// Parallel edges.
+	assert.Equal(t, 2, testColorings(t, getGraph(2, [][]int{{0, 1}, {1, 0}})))
+	assert.Equal(t, 4, testColorings(t, getGrotzschGraph()))
+	assert.Equal(t, 1, testColorings(t, getGraph(3, nil)))
+	assert.Equal(t, 0, testColorings(t, getGraph(0, nil)))
+
+	complete, err := generate.Complete(6, NewUndirectedGraph)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, testColorings(t, complete.(*UndirectedGraph)))
+}
+
+func TestUndirectedGraph_ColoringHeuristics(t *testing.T) {
+	// Crown graph: u_i is adjacent to v_j for i != j, with u_i = 2i and v_i = 2i+1.
+	// Coloring in the order u_0, v_0, u_1, v_1, ... uses a color for every pair.
+	var pairs [][]int
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 5; j++ {
+			if i != j {
+				pairs = append(pairs, []int{2 * i, 2*j + 1})
+			}
+		}
+	}
+	crown := getGraph(10, pairs)
+	wp, _ := crown.WelshPowellColoring()
+	assert.Equal(t, 5, wp.NumColors())
+	// DSatur colors bipartite graphs optimally.
+	dsatur, _ := crown.DSaturColoring()
+	assert.Equal(t, 2, dsatur.NumColors())
+
+	// Trees are 1-degenerate, so smallest-last uses at most 2 colors.
+	tree, _ := generate.RandomTree(200, 42, NewUndirectedGraph)
+	sl, err := tree.(*UndirectedGraph).SmallestLastColoring()
+	assert.NoError(t, err)
+	testValidColoring(t, tree.(*UndirectedGraph), sl)
+	assert.Equal(t, 2, sl.NumColors())
+
+	// The largest degree d bounds the colors used to d+1.
+	g, _ := generate.ErdosRenyi(300, 0.05, 42, NewUndirectedGraph)
+	ug := g.(*UndirectedGraph)
+	var maxDegree = 0
+	for v := 0; v < ug.GetV(); v++ {
+		d, _ := ug.Degree(v)
+		if d > maxDegree {
+			maxDegree = d
+		}
+	}
+	for _, colorer := range []func() (*Coloring, error){
+		ug.WelshPowellColoring, ug.SmallestLastColoring, ug.DSaturColoring} {
+		c, err := colorer()
+		assert.NoError(t, err)
+		testValidColoring(t, ug, c)
+		assert.True(t, c.NumColors() <= maxDegree+1)
+	}
+}
+
+// chromaticNumber finds the fewest colors needed by trying every coloring.
+func chromaticNumber(ug *UndirectedGraph) int {
+	var n = ug.GetV()
+	for k := 1; ; k++ {
+		var color = make([]int, n)
+		for {
+			var valid = true
+			for v := 0; v < n && valid; v++ {
+				adjList, _ := ug.Adjacent(v)
+				for _, w := range adjList {
+					if color[v] == color[w] {
+						valid = false
+						break
+					}
+				}
+			}
+			if valid {
+				return k
+			}
+			// Next coloring, counting in base k.
+			var i = 0
+			for i < n && color[i] == k-1 {
+				color[i] = 0
+				i++
+			}
+			if i == n {
+				break
+			}
+			color[i]++
+		}
+	}
+}
+
+func TestUndirectedGraph_ExactColoringRandom(t *testing.T) {
+	for seed := int64(0); seed < 30; seed++ {
+		g, _ := generate.ErdosRenyi(7, 0.5, seed, NewUndirectedGraph)
+		ug := g.(*UndirectedGraph)
+		assert.Equal(t, chromaticNumber(ug), testColorings(t, ug))
+	}
+}
+
+func TestUndirectedGraph_ExactColoringBudget(t *testing.T) {
+	// The search needs to rule out 3 colors, as the largest clique has 2 vertices.
+	ug := getGrotzschGraph()
+	c, optimal, err := ug.ExactColoring(0)
+	assert.NoError(t, err)
+	assert.False(t, optimal)
+	testValidColoring(t, ug, c)
+	dsatur, _ := ug.DSaturColoring()
+	assert.Equal(t, dsatur.NumColors(), c.NumColors())
+}
+
+func TestUndirectedGraph_ColoringSelfLoop(t *testing.T) {
+	ug := getGraph(3, [][]int{{0, 1}, {2, 2}})
+	_, err := ug.WelshPowellColoring()
+	assert.Error(t, err)
+	_, err = ug.SmallestLastColoring()
+	assert.Error(t, err)
+	_, err = ug.DSaturColoring()
+	assert.Error(t, err)
+	_, _, err = ug.ExactColoring(time.Second)
+	assert.Error(t, err)
+	_, _, err = ug.ChromaticNumberBounds()
+	assert.Error(t, err)
+}
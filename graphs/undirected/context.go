@@ -0,0 +1,87 @@
+package undirected
+
+import "context"
+
+// The context variants below are the ones in graphs.Traversal, which describes how they stop once
+// the context is cancelled.
+
+// DfsContext is the cancellable variant of Dfs.
+func (g UndirectedGraph) DfsContext(ctx context.Context) ([]int, error) {
+	return newTraversal(&g).Dfs(ctx)
+}
+
+// BfsContext is the cancellable variant of Bfs.
+func (g UndirectedGraph) BfsContext(ctx context.Context) ([]int, error) {
+	return newTraversal(&g).Bfs(ctx)
+}
+
+// ConnectedVerticesContext is the cancellable variant of ConnectedVertices.
+// Returns error if the source does not exist.
+func (g UndirectedGraph) ConnectedVerticesContext(ctx context.Context, source int) ([]int, error) {
+	return newTraversal(&g).ConnectedVertices(ctx, source)
+}
+
+// FindPathContext is the cancellable variant of FindPath.
+// Returns error if either of the vertices does not exist.
+func (g UndirectedGraph) FindPathContext(ctx context.Context, source, dest int) ([]int, bool, error) {
+	return newTraversal(&g).FindPath(ctx, source, dest)
+}
+
+// FindConnectedComponentsContext is the cancellable variant of FindConnectedComponents.
+func (g UndirectedGraph) FindConnectedComponentsContext(ctx context.Context) ([][]int, error) {
+	return newTraversal(&g).ConnectedComponents(ctx)
+}
+
+// DfsContext is the cancellable variant of Dfs.
+func (g AdjacencyMatrixGraph) DfsContext(ctx context.Context) ([]int, error) {
+	return newTraversal(&g).Dfs(ctx)
+}
+
+// BfsContext is the cancellable variant of Bfs.
+func (g AdjacencyMatrixGraph) BfsContext(ctx context.Context) ([]int, error) {
+	return newTraversal(&g).Bfs(ctx)
+}
+
+// ConnectedVerticesContext is the cancellable variant of ConnectedVertices.
+// Returns error if the source does not exist.
+func (g AdjacencyMatrixGraph) ConnectedVerticesContext(ctx context.Context, source int) ([]int, error) {
+	return newTraversal(&g).ConnectedVertices(ctx, source)
+}
+
+// FindPathContext is the cancellable variant of FindPath.
+// Returns error if either of the vertices does not exist.
+func (g AdjacencyMatrixGraph) FindPathContext(ctx context.Context, source, dest int) ([]int, bool, error) {
+	return newTraversal(&g).FindPath(ctx, source, dest)
+}
+
+// FindConnectedComponentsContext is the cancellable variant of FindConnectedComponents.
+func (g AdjacencyMatrixGraph) FindConnectedComponentsContext(ctx context.Context) ([][]int, error) {
+	return newTraversal(&g).ConnectedComponents(ctx)
+}
+
+// DfsContext is the cancellable variant of Dfs.
+func (g CSRGraph) DfsContext(ctx context.Context) ([]int, error) {
+	return newTraversal(&g).Dfs(ctx)
+}
+
+// BfsContext is the cancellable variant of Bfs.
+func (g CSRGraph) BfsContext(ctx context.Context) ([]int, error) {
+	return newTraversal(&g).Bfs(ctx)
+}
+
+// ConnectedVerticesContext is the cancellable variant of ConnectedVertices.
+// Returns error if the source does not exist.
+func (g CSRGraph) ConnectedVerticesContext(ctx context.Context, source int) ([]int, error) {
+	return newTraversal(&g).ConnectedVertices(ctx, source)
+}
+
+// FindPathContext is the cancellable variant of FindPath.
+// Returns error if either of the vertices does not exist.
+func (g CSRGraph) FindPathContext(ctx context.Context, source, dest int) ([]int, bool, error) {
+	return newTraversal(&g).FindPath(ctx, source, dest)
+}
+
+// FindConnectedComponentsContext is the cancellable variant of FindConnectedComponents.
+func (g CSRGraph) FindConnectedComponentsContext(ctx context.Context) ([][]int, error) {
+	return newTraversal(&g).ConnectedComponents(ctx)
+}
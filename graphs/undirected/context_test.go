@@ -0,0 +1,153 @@
+package undirected
+
+import (
+	"context"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// countdownContext is a context that is cancelled after Err() has been called n times.
+// It is used to cancel a walk at a known point.
+type countdownContext struct {
+	context.Context
+	n int
+}
+
+func (ctx *countdownContext) Err() error {
+	if ctx.n <= 0 {
+		return context.Canceled
+	}
+	ctx.n--
+	return nil
+}
+
+func getLongPath(n int) *UndirectedGraph {
+	ug := NewUndirectedGraph(n).(*UndirectedGraph)
+	for v := 0; v+1 < n; v++ {
+		ug.AddEdge(v, v+1)
+	}
+	return ug
+}
+
+func TestUndirectedGraph_LongPath(t *testing.T) {
+	const n = 200000
+	ug := getLongPath(n)
+
+	dfsOrder := ug.Dfs()
+	assert.Len(t, dfsOrder, n)
+	for i, v := range dfsOrder {
+		assert.Equal(t, i, v)
+	}
+	connected, validVertex := ug.ConnectedVertices(n - 1)
+	assert.True(t, validVertex)
+	assert.Len(t, connected, n)
+	path, found := ug.FindPath(0, n-1)
+	assert.True(t, found)
+	assert.Len(t, path, n)
+	pathV2, found := ug.FindPathV2(0, n-1)
+	assert.True(t, found)
+	assert.Equal(t, path, pathV2)
+	assert.Len(t, ug.FindConnectedComponents(), 1)
+	assert.False(t, ug.HasCycle())
+
+	m, err := ug.MaximumMatching()
+	assert.NoError(t, err)
+	assert.Equal(t, n/2, m.Size())
+}
+
+func TestUndirectedGraph_CancellableGraph(t *testing.T) {
+	var ug graphs.Graph = getUndirectedGraph(t)
+	_, ok := ug.(graphs.CancellableGraph)
+	assert.True(t, ok)
+}
+
+func TestUndirectedGraph_DfsContext(t *testing.T) {
+	ug := getUndirectedGraph(t).(*UndirectedGraph)
+	result, err := ug.DfsContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, ug.Dfs(), result)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err = ug.DfsContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+	assert.Empty(t, result)
+
+	// The context is checked before visiting every vertex.
+	result, err = ug.DfsContext(&countdownContext{Context: context.Background(), n: 5})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, ug.Dfs()[:5], result)
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+	_, err = ug.DfsContext(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestUndirectedGraph_BfsContext(t *testing.T) {
+	ug := getUndirectedGraph(t).(*UndirectedGraph)
+	result, err := ug.BfsContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, ug.Bfs(), result)
+
+	result, err = ug.BfsContext(&countdownContext{Context: context.Background(), n: 4})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, ug.Bfs()[:4], result)
+}
+
+func TestUndirectedGraph_ConnectedVerticesContext(t *testing.T) {
+	ug := getUndirectedGraph(t).(*UndirectedGraph)
+	for v := 0; v < 13; v++ {
+		expected, _ := ug.ConnectedVertices(v)
+		result, err := ug.ConnectedVerticesContext(context.Background(), v)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	}
+
+	result, err := ug.ConnectedVerticesContext(&countdownContext{Context: context.Background(), n: 2}, 0)
+	assert.Equal(t, context.Canceled, err)
+	assert.Len(t, result, 2)
+
+	_, err = ug.ConnectedVerticesContext(context.Background(), 13)
+	assert.Error(t, err)
+	_, err = ug.ConnectedVerticesContext(context.Background(), -1)
+	assert.Error(t, err)
+}
+
+func TestUndirectedGraph_FindPathContext(t *testing.T) {
+	ug := getUndirectedGraph(t).(*UndirectedGraph)
+	for _, p := range [][]int{{0, 3}, {3, 0}, {9, 12}, {0, 0}, {0, 7}} {
+		expected, expectedFound := ug.FindPath(p[0], p[1])
+		path, found, err := ug.FindPathContext(context.Background(), p[0], p[1])
+		assert.NoError(t, err)
+		assert.Equal(t, expectedFound, found)
+		assert.Equal(t, expected, path)
+	}
+
+	const n = 1000
+	longPath := getLongPath(n)
+	path, found, err := longPath.FindPathContext(&countdownContext{Context: context.Background(), n: n / 2}, 0, n-1)
+	assert.Equal(t, context.Canceled, err)
+	assert.False(t, found)
+	assert.Empty(t, path)
+
+	_, _, err = ug.FindPathContext(context.Background(), 0, 13)
+	assert.Error(t, err)
+	_, _, err = ug.FindPathContext(context.Background(), -1, 0)
+	assert.Error(t, err)
+}
+
+func TestUndirectedGraph_FindConnectedComponentsContext(t *testing.T) {
+	ug := getUndirectedGraph(t).(*UndirectedGraph)
+	result, err := ug.FindConnectedComponentsContext(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, ug.FindConnectedComponents(), result)
+
+	// Cancelled while visiting the second component.
+	result, err = ug.FindConnectedComponentsContext(&countdownContext{Context: context.Background(), n: 8})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, ug.FindConnectedComponents()[:1], result)
+}
@@ -0,0 +1,184 @@
+package undirected
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+)
+
+// CSRGraph is an immutable undirected Graph stored in the compressed sparse row format.
+// The adjacency lists of all the vertices are laid out one after the other in a single array,
+// and the adjacency list of v is targets[offsets[v]:offsets[v+1]].
+// Traversals therefore read memory sequentially instead of chasing pointers, which matters for
+// graphs with millions of vertices.
+//
+// The graph cannot be modified once built. AddEdge, RemoveEdge and RemoveVertex return false,
+// and AddVertex returns -1.
+type CSRGraph struct {
+	offsets  []int // V+1 offsets into targets.
+	targets  []int // 2E adjacent vertices.
+	numEdges int
+}
+
+// NewCSRGraph creates an undirected graph with v vertices and the given edges.
+// Parallel edges and self-loops are allowed, and are stored just like in UndirectedGraph. The
+// adjacent vertices are also in the same order as they would be if the edges were added to an
+// UndirectedGraph one at a time.
+// Returns error if v is negative or an edge connects a vertex that does not exist.
+func NewCSRGraph(v int, edges [][2]int) (graphs.Graph, error) {
+	if v < 0 {
+		return nil, errors.Errorf("invalid number of vertices %d", v)
+	}
+	var offsets = make([]int, v+1)
+	for _, e := range edges {
+		for _, w := range e {
+			if (w < 0) || (w >= v) {
+				return nil, errors.Errorf("edge %d-%d connects vertex %d that does not exist",
+					e[0], e[1], w)
+			}
+		}
+		offsets[e[0]+1]++
+		offsets[e[1]+1]++
+	}
+	for i := 1; i <= v; i++ {
+		offsets[i] += offsets[i-1]
+	}
+
+	// An UndirectedGraph adds every edge to the front of the adjacency lists, so the edges are
+	// filled in reverse to end up with the most recently added edge first.
+	var targets = make([]int, 2*len(edges))
+	var next = make([]int, v)
+	copy(next, offsets[:v])
+	for i := len(edges) - 1; i >= 0; i-- {
+		v1, v2 := edges[i][0], edges[i][1]
+		targets[next[v1]] = v2
+		next[v1]++
+		targets[next[v2]] = v1
+		next[v2]++
+	}
+
+	return &CSRGraph{
+		offsets:  offsets,
+		targets:  targets,
+		numEdges: len(edges),
+	}, nil
+}
+
+func (g CSRGraph) GetV() int {
+	return len(g.offsets) - 1
+}
+
+func (g CSRGraph) GetE() int {
+	return g.numEdges
+}
+
+// isValid returns whether the given vertex exists in the graph.
+func (g CSRGraph) isValid(v int) bool {
+	return (v >= 0) && (v < len(g.offsets)-1)
+}
+
+func (g CSRGraph) AddEdge(int, int) bool {
+	return false
+}
+
+// HasEdge scans the adjacency list of v1 - O(degree).
+func (g CSRGraph) HasEdge(v1 int, v2 int) bool {
+	if !g.isValid(v1) || !g.isValid(v2) {
+		return false
+	}
+	for _, w := range g.adjacentView(v1) {
+		if w == v2 {
+			return true
+		}
+	}
+	return false
+}
+
+func (g CSRGraph) RemoveEdge(int, int) bool {
+	return false
+}
+
+func (g CSRGraph) AddVertex() int {
+	return -1
+}
+
+func (g CSRGraph) RemoveVertex(int) bool {
+	return false
+}
+
+func (g CSRGraph) Adjacent(v int) ([]int, bool) {
+	var adjVertices []int
+	if !g.isValid(v) {
+		return adjVertices, false
+	}
+	adjVertices = make([]int, g.offsets[v+1]-g.offsets[v])
+	copy(adjVertices, g.adjacentView(v))
+	return adjVertices, true
+}
+
+// adjacentView returns the vertices adjacent to v without copying them, for the traversals that
+// only read them. Assumes that v exists.
+func (g CSRGraph) adjacentView(v int) []int {
+	return g.targets[g.offsets[v]:g.offsets[v+1]]
+}
+
+func (g CSRGraph) Degree(v int) (int, bool) {
+	if !g.isValid(v) {
+		return -1, false
+	}
+	return g.offsets[v+1] - g.offsets[v], true
+}
+
+func (g CSRGraph) InDegree(v int) (int, bool) {
+	return g.Degree(v)
+}
+
+func (g CSRGraph) OutDegree(v int) (int, bool) {
+	return g.Degree(v)
+}
+
+func (g CSRGraph) String() string {
+	var buf = new(bytes.Buffer)
+	for v := 0; v < g.GetV(); v++ {
+		buf.WriteString(fmt.Sprintf("%d => ", v))
+		buf.WriteString(fmt.Sprintf("%v\n", g.adjacentView(v)))
+	}
+	return buf.String()
+}
+
+func (g CSRGraph) Dfs() []int {
+	result, _ := newTraversal(&g).Dfs(context.Background())
+	return result
+}
+
+func (g CSRGraph) Bfs() []int {
+	result, _ := newTraversal(&g).Bfs(context.Background())
+	return result
+}
+
+// Walk runs a depth first walk from start, making the callbacks in visitor.
+// Return false if vertex does not exist.
+func (g CSRGraph) Walk(start int, visitor graphs.Visitor) bool {
+	return walk(&g, start, visitor)
+}
+
+func (g CSRGraph) ConnectedVertices(source int) ([]int, bool) {
+	connected, err := newTraversal(&g).ConnectedVertices(context.Background(), source)
+	return connected, err == nil
+}
+
+func (g CSRGraph) FindPath(source, dest int) ([]int, bool) {
+	path, found, _ := newTraversal(&g).FindPath(context.Background(), source, dest)
+	return path, found
+}
+
+func (g CSRGraph) FindPathV2(source, dest int) ([]int, bool) {
+	return newTraversal(&g).FindPathV2(source, dest)
+}
+
+func (g CSRGraph) FindConnectedComponents() [][]int {
+	connectedComponents, _ := newTraversal(&g).ConnectedComponents(context.Background())
+	return connectedComponents
+}
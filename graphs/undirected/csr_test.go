@@ -0,0 +1,119 @@
+package undirected
+
+import (
+	"context"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// tinyGEdges are the edges of getUndirectedGraph, in the same order.
+var tinyGEdges = [][2]int{
+	{0, 5}, {4, 3}, {0, 1}, {9, 12}, {6, 4}, {5, 4}, {0, 2}, {11, 12}, {9, 10},
+	{0, 6}, {7, 8}, {9, 11}, {5, 3},
+}
+
+// testSameGraph checks that both the graphs have the same vertices, edges and traversals.
+func testSameGraph(t *testing.T, expected, actual graphs.Graph) {
+	assert.Equal(t, expected.GetV(), actual.GetV())
+	assert.Equal(t, expected.GetE(), actual.GetE())
+	assert.Equal(t, expected.String(), actual.String())
+	for v := 0; v < expected.GetV(); v++ {
+		expectedDegree, _ := expected.Degree(v)
+		degree, ok := actual.Degree(v)
+		assert.True(t, ok)
+		assert.Equal(t, expectedDegree, degree)
+		for w := 0; w < expected.GetV(); w++ {
+			assert.Equal(t, expected.HasEdge(v, w), actual.HasEdge(v, w))
+		}
+	}
+	assert.Equal(t, expected.Dfs(), actual.Dfs())
+	assert.Equal(t, expected.Bfs(), actual.Bfs())
+	assert.Equal(t, expected.FindConnectedComponents(), actual.FindConnectedComponents())
+	for v := 0; v < expected.GetV(); v++ {
+		expectedPath, expectedFound := expected.FindPath(0, v)
+		path, found := actual.FindPath(0, v)
+		assert.Equal(t, expectedFound, found)
+		assert.Equal(t, expectedPath, path)
+		expectedPath, expectedFound = expected.FindPathV2(0, v)
+		path, found = actual.FindPathV2(0, v)
+		assert.Equal(t, expectedFound, found)
+		assert.Equal(t, expectedPath, path)
+	}
+}
+
+func TestNewCSRGraph(t *testing.T) {
+	csr, err := NewCSRGraph(13, tinyGEdges)
+	assert.NoError(t, err)
+	testSameGraph(t, getUndirectedGraph(t), csr)
+
+	_, err = NewCSRGraph(-1, nil)
+	assert.Error(t, err)
+	_, err = NewCSRGraph(3, [][2]int{{0, 1}, {1, 3}})
+	assert.Error(t, err)
+
+	empty, err := NewCSRGraph(0, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, empty.GetV())
+	assert.Empty(t, empty.Dfs())
+}
+
+func TestCSRGraph_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 20; i++ {
+		// Includes parallel edges and self-loops.
+		ug := NewUndirectedGraph(20)
+		var edges [][2]int
+		for j := 0; j < 30; j++ {
+			e := [2]int{r.Intn(20), r.Intn(20)}
+			edges = append(edges, e)
+			ug.AddEdge(e[0], e[1])
+		}
+		csr, err := NewCSRGraph(20, edges)
+		assert.NoError(t, err)
+		testSameGraph(t, ug, csr)
+	}
+}
+
+func TestCSRGraph_Immutable(t *testing.T) {
+	csr, err := NewCSRGraph(13, tinyGEdges)
+	assert.NoError(t, err)
+	assert.False(t, csr.AddEdge(1, 2))
+	assert.False(t, csr.RemoveEdge(0, 5))
+	assert.Equal(t, -1, csr.AddVertex())
+	assert.False(t, csr.RemoveVertex(0))
+	assert.Equal(t, 13, csr.GetV())
+	assert.Equal(t, 13, csr.GetE())
+
+	adjList, ok := csr.Adjacent(0)
+	assert.True(t, ok)
+	assert.Equal(t, []int{6, 2, 1, 5}, adjList)
+	// The adjacency list returned is a copy.
+	adjList[0] = 12
+	sort.Ints(adjList)
+	adjList, _ = csr.Adjacent(0)
+	assert.Equal(t, []int{6, 2, 1, 5}, adjList)
+	adjList, _ = csr.Adjacent(1)
+	assert.Equal(t, []int{0}, adjList)
+	_, ok = csr.Adjacent(13)
+	assert.False(t, ok)
+}
+
+func TestCSRGraph_CancellableGraph(t *testing.T) {
+	csr, err := NewCSRGraph(13, tinyGEdges)
+	assert.NoError(t, err)
+	cg, ok := csr.(graphs.CancellableGraph)
+	assert.True(t, ok)
+
+	dfsOrder, err := cg.DfsContext(&countdownContext{Context: context.Background(), n: 3})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, csr.Dfs()[:3], dfsOrder)
+	_, err = cg.ConnectedVerticesContext(context.Background(), 13)
+	assert.Error(t, err)
+	path, found, err := cg.FindPathContext(context.Background(), 0, 3)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []int{0, 6, 4, 5, 3}, path)
+}
@@ -0,0 +1,105 @@
+package undirected
+
+import "github.com/pradykaushik/data-structures/graphs"
+
+// HasCycle returns whether the graph contains a cycle.
+// Self-loops and parallel edges are considered to be cycles.
+func (g UndirectedGraph) HasCycle() bool {
+	_, found := g.findCycle()
+	return found
+}
+
+// Cycle returns a cycle in the graph if one exists.
+// The first and the last vertices of the cycle are the same.
+// An empty cycle is returned if the graph is acyclic, i.e., a forest.
+//
+// A self-loop on v is returned as [v v] and parallel edges between v and w as [v w v].
+func (g UndirectedGraph) Cycle() []int {
+	cycle, _ := g.findCycle()
+	return cycle
+}
+
+func (g UndirectedGraph) findCycle() ([]int, bool) {
+	if cycle, found := g.findSelfLoop(); found {
+		return cycle, true
+	}
+	if cycle, found := g.findParallelEdges(); found {
+		return cycle, true
+	}
+
+	var walker = graphs.NewWalker(&g)
+	var parentTracker = make([]int, len(g.gph))
+	var cycle = make([]int, 0, 0)
+	for v := range g.gph {
+		if walker.Visited(v) {
+			continue
+		}
+		if g.findCycleDfs(walker, v, parentTracker, &cycle) {
+			return cycle, true
+		}
+	}
+	return cycle, false
+}
+
+// findSelfLoop returns a self-loop if one exists.
+func (g UndirectedGraph) findSelfLoop() ([]int, bool) {
+	for v := range g.gph {
+		adjList, _ := g.Adjacent(v)
+		for _, w := range adjList {
+			if v == w {
+				return []int{v, v}, true
+			}
+		}
+	}
+	return []int{}, false
+}
+
+// findParallelEdges returns a pair of parallel edges if they exist.
+// Assumes that there are no self-loops.
+func (g UndirectedGraph) findParallelEdges() ([]int, bool) {
+	for v := range g.gph {
+		var seen = make(map[int]struct{})
+		adjList, _ := g.Adjacent(v)
+		for _, w := range adjList {
+			if _, ok := seen[w]; ok {
+				return []int{v, w, v}, true
+			}
+			seen[w] = struct{}{}
+		}
+	}
+	return []int{}, false
+}
+
+// findCycleDfs runs a dfs from source.
+// As there are no self-loops or parallel edges, a back edge v-w closes a cycle with the path from
+// the ancestor w down to v in the dfs tree. The cycle is traced back from v to w using the parent of
+// each vertex.
+func (g UndirectedGraph) findCycleDfs(
+	walker *graphs.Walker,
+	source int,
+	parentTracker []int,
+	cycle *[]int) bool {
+
+	var found = false
+	walker.Walk(source, graphs.Visitor{
+		TreeEdge: func(v, w int) graphs.WalkSignal {
+			parentTracker[w] = v
+			return graphs.Continue
+		},
+		BackEdge: func(v, w int) graphs.WalkSignal {
+			// Found the cycle w - ... - v - w.
+			// The vertices are traced back from v, and then reversed.
+			*cycle = append(*cycle, w)
+			for x := v; x != w; x = parentTracker[x] {
+				*cycle = append(*cycle, x)
+			}
+			*cycle = append(*cycle, w)
+			for i, j := 0, len(*cycle)-1; i < j; i, j = i+1, j-1 {
+				(*cycle)[i], (*cycle)[j] = (*cycle)[j], (*cycle)[i]
+			}
+			found = true
+			return graphs.Stop
+		},
+	})
+	return found
+}
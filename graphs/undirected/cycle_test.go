@@ -0,0 +1,79 @@
+package undirected
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// testCycle checks that the given sequence of vertices is a cycle in the graph that
+// does not reuse an edge.
+func testCycle(t *testing.T, g graphs.Graph, cycle []int) {
+	assert.True(t, len(cycle) >= 2)
+	assert.Equal(t, cycle[0], cycle[len(cycle)-1])
+	// Counting the number of edges between every pair of vertices on the cycle.
+	var used = make(map[[2]int]int)
+	for i := 0; i+1 < len(cycle); i++ {
+		v, w := cycle[i], cycle[i+1]
+		if v > w {
+			v, w = w, v
+		}
+		used[[2]int{v, w}]++
+	}
+	for pair, count := range used {
+		var available = 0
+		adjL, _ := g.Adjacent(pair[0])
+		for _, w := range adjL {
+			if w == pair[1] {
+				available++
+			}
+		}
+		if pair[0] == pair[1] {
+			// Self-loops are present twice in the adjacency list.
+			available /= 2
+		}
+		assert.True(t, count <= available)
+	}
+}
+
+func TestUndirectedGraph_HasCycle(t *testing.T) {
+	ug := getUndirectedGraph(t).(*UndirectedGraph)
+	assert.True(t, ug.HasCycle())
+	testCycle(t, ug, ug.Cycle())
+
+	// A forest.
+	ug = NewUndirectedGraph(6).(*UndirectedGraph)
+	ug.AddEdge(0, 1)
+	ug.AddEdge(1, 2)
+	ug.AddEdge(1, 3)
+	ug.AddEdge(4, 5)
+	assert.False(t, ug.HasCycle())
+	assert.Empty(t, ug.Cycle())
+
+	// Closing a cycle in the second tree is not possible, but closing one in the first is.
+	ug.AddEdge(3, 0)
+	cycle := ug.Cycle()
+	assert.Len(t, cycle, 4)
+	testCycle(t, ug, cycle)
+	assert.ElementsMatch(t, []int{0, 1, 3}, cycle[:3])
+}
+
+func TestUndirectedGraph_CycleSelfLoop(t *testing.T) {
+	ug := NewUndirectedGraph(3).(*UndirectedGraph)
+	ug.AddEdge(0, 1)
+	ug.AddEdge(2, 2)
+	assert.True(t, ug.HasCycle())
+	assert.Equal(t, []int{2, 2}, ug.Cycle())
+}
+
+func TestUndirectedGraph_CycleParallelEdges(t *testing.T) {
+	ug := NewUndirectedGraph(3).(*UndirectedGraph)
+	ug.AddEdge(0, 1)
+	ug.AddEdge(1, 2)
+	ug.AddEdge(2, 1)
+	assert.True(t, ug.HasCycle())
+	cycle := ug.Cycle()
+	assert.Len(t, cycle, 3)
+	testCycle(t, ug, cycle)
+	assert.ElementsMatch(t, []int{1, 2}, cycle[:2])
+}
@@ -0,0 +1,135 @@
+package undirected
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+)
+
+// EulerianCircuit returns a sequence of edges that uses every edge exactly once and ends
+// at the vertex it started from. The endpoints of every pair are in the order of traversal.
+// Returns error explaining why if no such circuit exists.
+//
+// A circuit exists if and only if every vertex has even degree and all the edges are connected.
+// A graph without edges has an empty circuit.
+func (g UndirectedGraph) EulerianCircuit() ([]graphs.Pair, error) {
+	for v := range g.gph {
+		if deg, _ := g.Degree(v); deg%2 != 0 {
+			return []graphs.Pair{}, errors.Errorf("no eulerian circuit: vertex %d has odd degree %d", v, deg)
+		}
+	}
+	return g.eulerian(-1)
+}
+
+// EulerianPath returns a sequence of edges that uses every edge exactly once.
+// The endpoints of every pair are in the order of traversal.
+// Returns error explaining why if no such path exists.
+//
+// A path exists if and only if zero or two vertices have odd degree and all the edges are
+// connected. If two vertices have odd degree, then the path starts at one and ends at the other.
+// Otherwise, the path is a circuit.
+func (g UndirectedGraph) EulerianPath() ([]graphs.Pair, error) {
+	var oddVertices = make([]int, 0, 0)
+	for v := range g.gph {
+		if deg, _ := g.Degree(v); deg%2 != 0 {
+			oddVertices = append(oddVertices, v)
+		}
+	}
+	if len(oddVertices) > 2 {
+		return []graphs.Pair{}, errors.Errorf("no eulerian path: %d vertices have odd degree %v",
+			len(oddVertices), oddVertices)
+	}
+	if len(oddVertices) == 2 {
+		return g.eulerian(oddVertices[0])
+	}
+	return g.eulerian(-1)
+}
+
+// eulerian finds the eulerian path starting at source using Hierholzer's algorithm, assuming
+// that the degrees of the vertices permit one. If source is -1, then any vertex with an edge is used.
+//
+// Starting from the source, unused edges are followed until a vertex with no unused edges is
+// reached, which can only be the end of the path. Vertices are then backtracked, and every vertex
+// with unused edges starts a detour that is spliced into the path at that vertex.
+// A vertex is returned to once for every pair of edges through it, and detours start from vertices
+// that have already been explored, so this is not a dfs over the vertices that graphs.Walker could
+// run. Instead, a stack of vertices is used so that long paths do not result in deep recursion.
+func (g UndirectedGraph) eulerian(source int) ([]graphs.Pair, error) {
+	// Every edge is present in the adjacency lists of both its endpoints.
+	// Numbering the edges so that an edge used from one endpoint is not used from the other.
+	var endpoints = make([][2]int, 0, 0)
+	var incident = make([][]int, len(g.gph))
+	for v := range g.gph {
+		var selfLoops = 0
+		adjList, _ := g.Adjacent(v)
+		for _, w := range adjList {
+			// Self-loops are present twice in the same adjacency list.
+			if (w > v) || ((w == v) && (selfLoops%2 == 0)) {
+				incident[v] = append(incident[v], len(endpoints))
+				if w != v {
+					incident[w] = append(incident[w], len(endpoints))
+				}
+				endpoints = append(endpoints, [2]int{v, w})
+			}
+			if w == v {
+				selfLoops++
+			}
+		}
+	}
+	if len(endpoints) == 0 {
+		return []graphs.Pair{}, nil
+	}
+
+	if source == -1 {
+		for v := range incident {
+			if len(incident[v]) > 0 {
+				source = v
+				break
+			}
+		}
+	}
+	// All the edges must be reachable from the source.
+	connected, _ := g.ConnectedVertices(source)
+	var reachable = make(map[int]struct{})
+	for _, v := range connected {
+		reachable[v] = struct{}{}
+	}
+	for v := range incident {
+		if _, ok := reachable[v]; !ok && (len(incident[v]) > 0) {
+			return []graphs.Pair{}, errors.Errorf(
+				"no eulerian path: edges at vertex %d are not connected to those at vertex %d", v, source)
+		}
+	}
+
+	var used = make([]bool, len(endpoints))
+	var next = make([]int, len(g.gph)) // index of the next edge to try in incident.
+	var vertexStack = []int{source}
+	var path = make([]graphs.Pair, 0, len(endpoints))
+	for len(vertexStack) > 0 {
+		v := vertexStack[len(vertexStack)-1]
+		for (next[v] < len(incident[v])) && used[incident[v][next[v]]] {
+			next[v]++
+		}
+		if next[v] < len(incident[v]) {
+			id := incident[v][next[v]]
+			used[id] = true
+			w := endpoints[id][0]
+			if w == v {
+				w = endpoints[id][1]
+			}
+			vertexStack = append(vertexStack, w)
+			continue
+		}
+		// No unused edges at v. The edge from the previous vertex to v is next on the path,
+		// when traversed from the end.
+		vertexStack = vertexStack[:len(vertexStack)-1]
+		if len(vertexStack) > 0 {
+			path = append(path, graphs.NewPair(vertexStack[len(vertexStack)-1], v))
+		}
+	}
+
+	// The path was built from the end.
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, nil
+}
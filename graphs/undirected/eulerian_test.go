@@ -0,0 +1,89 @@
+package undirected
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func getGraphFromPairs(v int, pairs [][]int) *UndirectedGraph {
+	ug := NewUndirectedGraph(v).(*UndirectedGraph)
+	for _, p := range pairs {
+		ug.AddEdge(p[0], p[1])
+	}
+	return ug
+}
+
+// testEulerianPath checks that the path is a walk that uses every pair exactly once.
+func testEulerianPath(t *testing.T, pairs [][]int, path []graphs.Pair) {
+	assert.Len(t, path, len(pairs))
+	var remaining = make(map[[2]int]int)
+	for _, p := range pairs {
+		remaining[sortedPair(p[0], p[1])]++
+	}
+	for i, e := range path {
+		if i > 0 {
+			assert.Equal(t, path[i-1].To(), e.From())
+		}
+		remaining[sortedPair(e.From(), e.To())]--
+	}
+	for _, count := range remaining {
+		assert.Zero(t, count)
+	}
+}
+
+func TestUndirectedGraph_EulerianCircuit(t *testing.T) {
+	// Two triangles sharing vertex 2, with a self-loop and parallel edges.
+	var pairs = [][]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 2}, {3, 3}, {0, 5}, {5, 0}}
+	circuit, err := getGraphFromPairs(6, pairs).EulerianCircuit()
+	assert.NoError(t, err)
+	testEulerianPath(t, pairs, circuit)
+	assert.Equal(t, circuit[0].From(), circuit[len(circuit)-1].To())
+
+	// Odd degrees.
+	ug := getGraphFromPairs(3, [][]int{{0, 1}, {1, 2}})
+	circuit, err = ug.EulerianCircuit()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "odd degree")
+	assert.Empty(t, circuit)
+
+	// Disconnected edges.
+	ug = getGraphFromPairs(6, [][]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}})
+	_, err = ug.EulerianCircuit()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not connected")
+
+	// No edges, and isolated vertices.
+	circuit, err = NewUndirectedGraph(3).(*UndirectedGraph).EulerianCircuit()
+	assert.NoError(t, err)
+	assert.Empty(t, circuit)
+	pairs = [][]int{{1, 2}, {2, 3}, {3, 1}}
+	circuit, err = getGraphFromPairs(5, pairs).EulerianCircuit()
+	assert.NoError(t, err)
+	testEulerianPath(t, pairs, circuit)
+}
+
+func TestUndirectedGraph_EulerianPath(t *testing.T) {
+	// Vertices 2 and 3 have odd degree.
+	var pairs = [][]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {0, 3}, {3, 4}, {4, 0}}
+	path, err := getGraphFromPairs(5, pairs).EulerianPath()
+	assert.NoError(t, err)
+	testEulerianPath(t, pairs, path)
+	assert.ElementsMatch(t, []int{2, 3}, []int{path[0].From(), path[len(path)-1].To()})
+
+	// A circuit is also a path.
+	pairs = [][]int{{0, 1}, {1, 2}, {2, 0}}
+	path, err = getGraphFromPairs(3, pairs).EulerianPath()
+	assert.NoError(t, err)
+	testEulerianPath(t, pairs, path)
+
+	// Four vertices with odd degree.
+	_, err = getGraphFromPairs(4, [][]int{{0, 1}, {2, 3}}).EulerianPath()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "odd degree")
+
+	// Disconnected edges.
+	_, err = getGraphFromPairs(5, [][]int{{0, 1}, {2, 3}, {3, 4}, {4, 2}}).EulerianPath()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not connected")
+}
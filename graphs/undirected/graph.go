@@ -2,12 +2,11 @@ package undirected
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/pradykaushik/data-structures/graphs"
 	"github.com/pradykaushik/data-structures/linkedlist"
-	"github.com/pradykaushik/data-structures/queue"
-	"github.com/pradykaushik/data-structures/queue/fifo"
-	"github.com/pradykaushik/data-structures/stack"
+	"github.com/pradykaushik/data-structures/util"
 )
 
 // UndirectedGraph is a Graph where the edges are not directed.
@@ -50,21 +49,88 @@ func (g UndirectedGraph) GetE() int {
 	return g.numEdges
 }
 
+// isValid returns whether the given vertex exists in the graph.
+func (g UndirectedGraph) isValid(v int) bool {
+	return (v >= 0) && (v < len(g.gph))
+}
+
 func (g *UndirectedGraph) AddEdge(v1 int, v2 int) bool {
 	// As this is an undirected graph, we need to add v1-v2 and v2-v1.
-	if (v1 >= len(g.gph)) || (v2 >= len(g.gph)) {
+	if !g.isValid(v1) || !g.isValid(v2) {
 		return false
 	}
 
 	g.gph[v1].AddToFront(Vertex(v2))
 	g.gph[v2].AddToFront(Vertex(v1))
+	g.numEdges++
+	return true
+}
+
+func (g UndirectedGraph) HasEdge(v1 int, v2 int) bool {
+	if !g.isValid(v1) || !g.isValid(v2) {
+		return false
+	}
+	return g.gph[v1].Search(Vertex(v2))
+}
+
+// RemoveEdge removes the edge v1-v2 from the adjacency lists of both the vertices.
+// A self-loop is present twice in the same adjacency list, and both are removed.
+func (g *UndirectedGraph) RemoveEdge(v1 int, v2 int) bool {
+	if !g.HasEdge(v1, v2) {
+		return false
+	}
+
+	g.gph[v1].DeleteFirst(Vertex(v2))
+	g.gph[v2].DeleteFirst(Vertex(v1))
+	g.numEdges--
+	return true
+}
+
+func (g *UndirectedGraph) AddVertex() int {
+	g.gph = append(g.gph, linkedlist.New())
+	g.numVertices++
+	return g.numVertices - 1
+}
+
+// RemoveVertex removes the vertex and its edges from the adjacency lists of its neighbours.
+// Renumbering the remaining vertices requires updating all the adjacency lists - O(V + E).
+func (g *UndirectedGraph) RemoveVertex(v int) bool {
+	if !g.isValid(v) {
+		return false
+	}
+
+	adjList, _ := g.Adjacent(v)
+	var selfLoops = 0
+	for _, w := range adjList {
+		if w == v {
+			selfLoops++
+			continue
+		}
+		g.gph[w].DeleteFirst(Vertex(v))
+		g.numEdges--
+	}
+	// Every self-loop is present twice.
+	g.numEdges -= selfLoops / 2
+
+	g.gph = append(g.gph[:v], g.gph[v+1:]...)
+	g.numVertices--
+	// Numbering every vertex above the removed one, one less.
+	var renumber = func(val util.Value) util.Value {
+		if w := val.Get().(int); w > v {
+			return Vertex(w - 1)
+		}
+		return val
+	}
+	for w := range g.gph {
+		g.gph[w].Map(renumber)
+	}
 	return true
 }
 
 func (g UndirectedGraph) Adjacent(v int) ([]int, bool) {
 	// we need to convert from []util.Value to []int.
 	var adjVertices []int
-	if v >= len(g.gph) {
+	if !g.isValid(v) {
 		return adjVertices, false
 	}
 
@@ -76,7 +142,7 @@ func (g UndirectedGraph) Adjacent(v int) ([]int, bool) {
 }
 
 func (g UndirectedGraph) Degree(v int) (int, bool) {
-	if v >= len(g.gph) {
+	if !g.isValid(v) {
 		return -1, false
 	}
 	return g.gph[v].Size(), true
@@ -104,96 +170,26 @@ func (g UndirectedGraph) String() string {
 }
 
 func (g UndirectedGraph) Dfs() []int {
-	if len(g.gph) == 0 {
-		return []int{}
-	}
-	var visited = make(map[int]struct{})
-	var result = make([]int, 0, 0)
-	for v := range g.gph {
-		if _, ok := visited[v]; !ok {
-			g.dfs(v, &visited, &result)
-		}
-	}
+	result, _ := newTraversal(&g).Dfs(context.Background())
 	return result
 }
 
-func (g UndirectedGraph) dfs(
-	v int,
-	visited *map[int]struct{},
-	result *[]int) {
-
-	(*result) = append(*result, v)
-	(*visited)[v] = struct{}{}
-	for _, adjV := range g.gph[v].SerializeIntoArray() {
-		if _, ok := (*visited)[adjV.Get().(int)]; !ok {
-			g.dfs(adjV.Get().(int), visited, result)
-		}
-	}
-}
-
 func (g UndirectedGraph) Bfs() []int {
-	if len(g.gph) == 0 {
-		return []int{}
-	}
-
-	var nextV = fifo.NewLinearQueueArr(len(g.gph))
-	var visited = make(map[int]struct{})
-	var result = make([]int, 0, 0)
-
-	for i := 0; i < len(g.gph); i++ {
-		if _, ok := visited[i]; !ok {
-			nextV.Enqueue(Vertex(i))
-			g.bfs(nextV, &visited, &result)
-		}
-	}
+	result, _ := newTraversal(&g).Bfs(context.Background())
 	return result
 }
 
-func (g UndirectedGraph) bfs(
-	next queue.Queue,
-	visited *map[int]struct{},
-	result *[]int) {
-
-	for !next.IsEmpty() {
-		nextV, _ := next.Dequeue()
-		v := nextV.Get().(int)
-		(*visited)[v] = struct{}{} // marking visited.
-		(*result) = append(*result, v)
-		adjList, _ := g.Adjacent(v)
-		for _, adjV := range adjList {
-			if _, ok := (*visited)[adjV]; !ok {
-				next.Enqueue(Vertex(adjV))
-				(*visited)[adjV] = struct{}{} // marking visited.
-			}
-		}
-	}
+// Walk runs a depth first walk from start, making the callbacks in visitor.
+// Return false if vertex does not exist.
+// See graphs.Visitor for how the edges are classified.
+func (g UndirectedGraph) Walk(start int, visitor graphs.Visitor) bool {
+	return walk(&g, start, visitor)
 }
 
 // All the vertices visited in a dfs are connected to the source vertex.
 func (g UndirectedGraph) ConnectedVertices(source int) ([]int, bool) {
-	if source >= len(g.gph) {
-		return []int{}, false
-	}
-
-	var connected = make([]int, 0, 0)
-	var visited = make(map[int]struct{})
-	g.connectedVertices(source, &visited, &connected)
-	return connected, true
-}
-
-func (g UndirectedGraph) connectedVertices(
-	v int,
-	visited *map[int]struct{},
-	connected *[]int) {
-
-	(*connected) = append(*connected, v)
-	(*visited)[v] = struct{}{}
-	adjList, _ := g.Adjacent(v)
-	for _, adjV := range adjList {
-		if _, ok := (*visited)[adjV]; !ok {
-			g.connectedVertices(adjV, visited, connected)
-		}
-	}
+	connected, err := newTraversal(&g).ConnectedVertices(context.Background(), source)
+	return connected, err == nil
 }
 
 // FindPath finds a path from source vertex to destination vertex.
@@ -201,132 +197,20 @@ func (g UndirectedGraph) connectedVertices(
 // When traversing the graph, store the parent vertices after each hop.
 // The parents are then traced back from the destination to the source.
 func (g UndirectedGraph) FindPath(source, dest int) ([]int, bool) {
-	if (source >= len(g.gph)) || (dest >= len(g.gph)) {
-		return []int{}, false
-	}
-
-	if source == dest {
-		return []int{source}, true
-	}
-
-	var found = false
-	var visited = make(map[int]struct{})
-	var parentTracker = make([]int, len(g.gph))
-	g.findPath(source, dest, source, &visited, &parentTracker, &found)
-	var path = make([]int, 0, 0)
-	var i = dest
-	if found {
-		for i != source {
-			path = append([]int{i}, path...)
-			i = parentTracker[i]
-		}
-		path = append([]int{i}, path...)
-	}
+	path, found, _ := newTraversal(&g).FindPath(context.Background(), source, dest)
 	return path, found
 }
 
-func (g UndirectedGraph) findPath(
-	source, dest int,
-	curV int,
-	visited *map[int]struct{},
-	parentTracker *[]int,
-	found *bool) {
-
-	(*parentTracker)[curV] = source
-	(*visited)[curV] = struct{}{}
-
-	if curV == dest {
-		*found = true
-		return
-	}
-
-	adjList, _ := g.Adjacent(curV)
-	for _, adjV := range adjList {
-		if _, ok := (*visited)[adjV]; !ok {
-			g.findPath(curV, dest, adjV, visited, parentTracker, found)
-		}
-	}
-}
-
 // Creating the path while traversing the graph.
 // If going down a path wasn't fruitful, then removing the corresponding vertices from path path.
 // This way, by the time we're done traversing the graph, we'll have the path - (V + E).
 //
 // Important note - the max path length = V.
 func (g UndirectedGraph) FindPathV2(source, dest int) ([]int, bool) {
-	if (source >= len(g.gph)) || (dest >= len(g.gph)) {
-		return []int{}, false
-	}
-
-	if source == dest {
-		return []int{source}, true
-	}
-
-	// Using a stack for constant time pop() operation.
-	// Could also use doubly linkedlist (might actually be more efficient).
-	var path = stack.NewArrayStack(len(g.gph))
-	var found = false
-	var visited = make(map[int]struct{})
-	g.findPathV2(source, dest, source, &visited, path, &found)
-	var pathArr []int
-	for !path.IsEmpty() {
-		v, _ := path.Pop()
-		pathArr = append([]int{v}, pathArr...)
-	}
-	return pathArr, found
-}
-
-func (g UndirectedGraph) findPathV2(
-	source, dest int,
-	curV int,
-	visited *map[int]struct{},
-	path stack.Stack,
-	found *bool) {
-
-	(*visited)[curV] = struct{}{} // marking as visited.
-	if curV == dest {
-		// we have found the path.
-		(*found) = true
-		path.Push(curV)
-		return
-	}
-
-	// keeping track of whether there aren't any more vertices to explore.
-	var moreToExplore = false
-	var toExplore []int
-	adjList, _ := g.Adjacent(curV)
-	for _, adjV := range adjList {
-		if _, ok := (*visited)[adjV]; !ok {
-			moreToExplore = true
-			toExplore = append(toExplore, adjV)
-		}
-	}
-
-	if moreToExplore {
-		path.Push(curV)
-		for _, v := range toExplore {
-			if _, ok := (*visited)[v]; !ok {
-				g.findPathV2(source, dest, v, visited, path, found)
-				if *found {
-					// this exploration was fruitful.
-					return
-				}
-			}
-		}
-		// If here, then none of the explorations from curV were fruitful.
-		path.Pop()
-	}
+	return newTraversal(&g).FindPathV2(source, dest)
 }
 
 func (g UndirectedGraph) FindConnectedComponents() [][]int {
-	var visited = make(map[int]struct{})
-	var connectedComponents = make([][]int, 0, 0)
-	for i := 0; i < len(g.gph); i++ {
-		var connected = make([]int, 0, 0)
-		if _, ok := visited[i]; !ok {
-			g.connectedVertices(i, &visited, &connected)
-			connectedComponents = append(connectedComponents, connected)
-		}
-	}
+	connectedComponents, _ := newTraversal(&g).ConnectedComponents(context.Background())
 	return connectedComponents
 }
@@ -205,3 +205,102 @@ func TestFindConnectedComponents(t *testing.T) {
 		[]int{9, 11, 12, 10},
 	}, connectedComponents)
 }
+
+func TestGetE(t *testing.T) {
+	ug := getUndirectedGraph(t)
+	assert.Equal(t, 13, ug.GetE())
+	ug.AddEdge(3, 3)
+	assert.Equal(t, 14, ug.GetE())
+	ug.AddEdge(13, 0)
+	assert.Equal(t, 14, ug.GetE())
+}
+
+func TestHasEdge(t *testing.T) {
+	ug := getUndirectedGraph(t)
+	assert.True(t, ug.HasEdge(0, 5))
+	assert.True(t, ug.HasEdge(5, 0))
+	assert.False(t, ug.HasEdge(0, 3))
+	assert.False(t, ug.HasEdge(0, 0))
+	assert.False(t, ug.HasEdge(0, 13))
+	assert.False(t, ug.HasEdge(-1, 0))
+}
+
+func TestRemoveEdge(t *testing.T) {
+	ug := getUndirectedGraph(t)
+	assert.True(t, ug.RemoveEdge(4, 5))
+	assert.Equal(t, 12, ug.GetE())
+	assert.False(t, ug.HasEdge(4, 5))
+	assert.False(t, ug.HasEdge(5, 4))
+	assert.False(t, ug.RemoveEdge(5, 4))
+	adjL, _ := ug.Adjacent(5)
+	assert.Equal(t, []int{3, 0}, adjL)
+	adjL, _ = ug.Adjacent(4)
+	assert.Equal(t, []int{6, 3}, adjL)
+
+	// Only one of the parallel edges is removed.
+	ug.AddEdge(7, 8)
+	assert.True(t, ug.RemoveEdge(8, 7))
+	assert.True(t, ug.HasEdge(7, 8))
+	assert.Equal(t, 12, ug.GetE())
+
+	// Both the occurrences of a self-loop are removed.
+	ug.AddEdge(1, 1)
+	deg, _ := ug.Degree(1)
+	assert.Equal(t, 3, deg)
+	assert.True(t, ug.RemoveEdge(1, 1))
+	deg, _ = ug.Degree(1)
+	assert.Equal(t, 1, deg)
+	assert.Equal(t, 12, ug.GetE())
+
+	assert.False(t, ug.RemoveEdge(0, 13))
+}
+
+func TestAddVertex(t *testing.T) {
+	ug := getUndirectedGraph(t)
+	assert.Equal(t, 13, ug.AddVertex())
+	assert.Equal(t, 14, ug.GetV())
+	assert.Equal(t, 13, ug.GetE())
+	adjL, validVertex := ug.Adjacent(13)
+	assert.True(t, validVertex)
+	assert.Empty(t, adjL)
+	assert.True(t, ug.AddEdge(13, 0))
+	assert.True(t, ug.HasEdge(0, 13))
+	assert.Len(t, ug.FindConnectedComponents(), 3)
+}
+
+func TestRemoveVertex(t *testing.T) {
+	ug := getUndirectedGraph(t)
+	ug.AddEdge(4, 4)
+	assert.True(t, ug.RemoveVertex(4))
+	assert.Equal(t, 12, ug.GetV())
+	// The 3 edges 4-3, 6-4 and 5-4 and the self-loop are removed.
+	assert.Equal(t, 10, ug.GetE())
+
+	// Vertices above 4 are numbered one less.
+	var expectedAdjLists = [][]int{
+		{5, 2, 1, 4},
+		{0},
+		{0},
+		{4},
+		{3, 0},
+		{0},
+		{7},
+		{6},
+		{10, 9, 11},
+		{8},
+		{8, 11},
+		{10, 8},
+	}
+	for v, expected := range expectedAdjLists {
+		adjL, _ := ug.Adjacent(v)
+		assert.Equal(t, expected, adjL)
+	}
+	_, validVertex := ug.Adjacent(12)
+	assert.False(t, validVertex)
+	assert.False(t, ug.RemoveVertex(12))
+
+	for ug.GetV() > 0 {
+		assert.True(t, ug.RemoveVertex(0))
+	}
+	assert.Equal(t, 0, ug.GetE())
+}
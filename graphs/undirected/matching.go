@@ -0,0 +1,215 @@
+package undirected
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/queue/fifo"
+)
+
+// Matching is a maximum cardinality matching in a bipartite graph, i.e., the largest set of edges
+// such that no two edges share an endpoint.
+//
+// mate[v] is the vertex that v is matched to, or -1 if v is not matched.
+// color[v] is the side of the bipartition that v belongs to.
+type Matching struct {
+	mate  []int
+	color []bool
+	size  int
+}
+
+// Size returns the number of edges in the matching.
+func (m Matching) Size() int {
+	return m.size
+}
+
+// Mate returns the vertex that the given vertex is matched to.
+// Return false if the vertex is not matched or does not exist.
+func (m Matching) Mate(v int) (int, bool) {
+	if (v < 0) || (v >= len(m.mate)) || (m.mate[v] == -1) {
+		return -1, false
+	}
+	return m.mate[v], true
+}
+
+// IsMatched returns whether the given vertex is matched.
+func (m Matching) IsMatched(v int) bool {
+	_, matched := m.Mate(v)
+	return matched
+}
+
+// Edges returns the matched pairs. Every pair starts from the vertex on the side of the
+// bipartition with color false and ends at the one with color true.
+func (m Matching) Edges() []graphs.Pair {
+	var edges = make([]graphs.Pair, 0, m.size)
+	for v, w := range m.mate {
+		if (w != -1) && !m.color[v] {
+			edges = append(edges, graphs.NewPair(v, w))
+		}
+	}
+	return edges
+}
+
+// MaximumMatching finds a maximum cardinality matching using the Hopcroft-Karp algorithm.
+// Returns error if the graph is not bipartite.
+//
+// A path that alternates between edges outside and inside the matching, and that starts and ends
+// at unmatched vertices, is an augmenting path. Flipping the edges along it grows the matching by 1.
+// In every phase, a bfs from all the unmatched vertices on one side finds the length of the shortest
+// augmenting paths, and a dfs then augments along a maximal set of vertex-disjoint shortest paths.
+// There are at most O(sqrt(V)) phases.
+func (g UndirectedGraph) MaximumMatching() (*Matching, error) {
+	var bipartition = g.Bipartition()
+	if !bipartition.IsBipartite() {
+		return nil, errors.Errorf("graph is not bipartite, found odd cycle %v", bipartition.OddCycle())
+	}
+
+	var m = &Matching{
+		mate:  make([]int, len(g.gph)),
+		color: bipartition.Coloring(),
+		size:  0,
+	}
+	for v := range m.mate {
+		m.mate[v] = -1
+	}
+	var adjLists = make([][]int, len(g.gph))
+	for v := range adjLists {
+		adjLists[v], _ = g.Adjacent(v)
+	}
+
+	// dist[u] is the number of matched edges on the shortest alternating path from an unmatched
+	// vertex to u, for vertices u with color false.
+	var dist = make([]int, len(g.gph))
+	var next = make([]int, len(g.gph)) // index of the next vertex to try in adjLists.
+	for g.matchingBfs(m, adjLists, dist) {
+		for u := range next {
+			next[u] = 0
+		}
+		for u := range adjLists {
+			if !m.color[u] && (m.mate[u] == -1) && g.matchingDfs(u, m, adjLists, dist, next) {
+				m.size++
+			}
+		}
+	}
+	return m, nil
+}
+
+// matchingBfs computes dist for the vertices with color false.
+// Returns whether there is an augmenting path.
+func (g UndirectedGraph) matchingBfs(m *Matching, adjLists [][]int, dist []int) bool {
+	// Every vertex is queued at most once.
+	var next = fifo.NewLinearQueueArr(len(g.gph))
+	for u := range adjLists {
+		dist[u] = -1
+		if !m.color[u] && (m.mate[u] == -1) {
+			dist[u] = 0
+			next.Enqueue(Vertex(u))
+		}
+	}
+
+	var found = false
+	for !next.IsEmpty() {
+		nextV, _ := next.Dequeue()
+		u := nextV.Get().(int)
+		for _, v := range adjLists[u] {
+			w := m.mate[v]
+			if w == -1 {
+				// v is unmatched, so the path to v is augmenting.
+				found = true
+			} else if dist[w] == -1 {
+				dist[w] = dist[u] + 1
+				next.Enqueue(Vertex(w))
+			}
+		}
+	}
+	return found
+}
+
+// matchingDfs looks for a shortest augmenting path from source and flips the edges along it.
+// Returns whether such a path was found.
+//
+// path holds the vertices with color false on the alternating path being explored. For every u on
+// the path, adjLists[u][next[u]] is the vertex it will be matched to if the path is augmenting.
+// The path is kept in a slice rather than on the call stack, as it can have up to V vertices.
+func (g UndirectedGraph) matchingDfs(source int, m *Matching, adjLists [][]int, dist, next []int) bool {
+	var path = []int{source}
+	for len(path) > 0 {
+		u := path[len(path)-1]
+		if next[u] == len(adjLists[u]) {
+			// No augmenting path through u in this phase.
+			dist[u] = -1
+			path = path[:len(path)-1]
+			if len(path) > 0 {
+				next[path[len(path)-1]]++
+			}
+			continue
+		}
+
+		v := adjLists[u][next[u]]
+		w := m.mate[v]
+		if w == -1 {
+			// v is unmatched, so the path is augmenting.
+			for _, x := range path {
+				y := adjLists[x][next[x]]
+				m.mate[x] = y
+				m.mate[y] = x
+			}
+			return true
+		}
+		if dist[w] == dist[u]+1 {
+			path = append(path, w)
+			continue
+		}
+		next[u]++
+	}
+	return false
+}
+
+// MinimumVertexCover returns the smallest set of vertices such that every edge has at least one
+// endpoint in the set, in increasing order. Returns error if the graph is not bipartite.
+//
+// By König's theorem, the size of the cover equals the size of a maximum matching. Let Z be the
+// vertices reachable from the unmatched vertices with color false using alternating paths. Then the
+// cover consists of the vertices with color false not in Z and the vertices with color true in Z.
+func (g UndirectedGraph) MinimumVertexCover() ([]int, error) {
+	m, err := g.MaximumMatching()
+	if err != nil {
+		return []int{}, err
+	}
+
+	var visited = make(map[int]struct{})
+	// Every vertex is queued at most once.
+	var next = fifo.NewLinearQueueArr(len(g.gph))
+	for u := range g.gph {
+		if !m.color[u] && (m.mate[u] == -1) {
+			visited[u] = struct{}{}
+			next.Enqueue(Vertex(u))
+		}
+	}
+	for !next.IsEmpty() {
+		nextV, _ := next.Dequeue()
+		u := nextV.Get().(int)
+		// Following edges outside the matching from u, and then the matched edge back.
+		adjList, _ := g.Adjacent(u)
+		for _, v := range adjList {
+			if _, ok := visited[v]; ok || (m.mate[u] == v) {
+				continue
+			}
+			visited[v] = struct{}{}
+			if w := m.mate[v]; w != -1 {
+				if _, ok := visited[w]; !ok {
+					visited[w] = struct{}{}
+					next.Enqueue(Vertex(w))
+				}
+			}
+		}
+	}
+
+	var cover = make([]int, 0, m.size)
+	for v := range g.gph {
+		_, inZ := visited[v]
+		if (!m.color[v] && !inZ) || (m.color[v] && inZ) {
+			cover = append(cover, v)
+		}
+	}
+	return cover, nil
+}
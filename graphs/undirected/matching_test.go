@@ -0,0 +1,122 @@
+package undirected
+
+import (
+	"github.com/pradykaushik/data-structures/graphs/flow"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+// maxMatchingSize computes the size of a maximum matching using maximum flow, where the
+// vertices on the two sides are connected to a new source and a new sink respectively.
+func maxMatchingSize(t *testing.T, ug *UndirectedGraph) int {
+	color := ug.Bipartition().Coloring()
+	s, sink := ug.GetV(), ug.GetV()+1
+	fn := flow.NewFlowNetwork(ug.GetV() + 2)
+	for v := 0; v < ug.GetV(); v++ {
+		if color[v] {
+			fn.AddEdge(v, sink, 1)
+			continue
+		}
+		fn.AddEdge(s, v, 1)
+		adjL, _ := ug.Adjacent(v)
+		for _, w := range adjL {
+			fn.AddEdge(v, w, 1)
+		}
+	}
+	mf, err := flow.Dinic(fn, s, sink)
+	assert.NoError(t, err)
+	return int(mf.Value() + 0.5)
+}
+
+// testMatching checks that the matching is valid, maximum and that the vertex cover covers
+// every edge and has the same size.
+func testMatching(t *testing.T, ug *UndirectedGraph) {
+	m, err := ug.MaximumMatching()
+	assert.NoError(t, err)
+	assert.Equal(t, maxMatchingSize(t, ug), m.Size())
+	assert.Len(t, m.Edges(), m.Size())
+	var matched = make(map[int]struct{})
+	for _, e := range m.Edges() {
+		assert.True(t, isEdge(ug, e.From(), e.To()))
+		mate, ok := m.Mate(e.From())
+		assert.True(t, ok)
+		assert.Equal(t, e.To(), mate)
+		mate, ok = m.Mate(e.To())
+		assert.True(t, ok)
+		assert.Equal(t, e.From(), mate)
+		matched[e.From()] = struct{}{}
+		matched[e.To()] = struct{}{}
+	}
+	assert.Len(t, matched, 2*m.Size())
+	for v := 0; v < ug.GetV(); v++ {
+		_, ok := matched[v]
+		assert.Equal(t, ok, m.IsMatched(v))
+	}
+
+	cover, err := ug.MinimumVertexCover()
+	assert.NoError(t, err)
+	assert.Len(t, cover, m.Size())
+	var inCover = make(map[int]struct{})
+	for _, v := range cover {
+		inCover[v] = struct{}{}
+	}
+	for v := 0; v < ug.GetV(); v++ {
+		adjL, _ := ug.Adjacent(v)
+		for _, w := range adjL {
+			_, vIn := inCover[v]
+			_, wIn := inCover[w]
+			assert.True(t, vIn || wIn)
+		}
+	}
+}
+
+func isEdge(ug *UndirectedGraph, v, w int) bool {
+	adjL, _ := ug.Adjacent(v)
+	for _, x := range adjL {
+		if x == w {
+			return true
+		}
+	}
+	return false
+}
+
+func TestUndirectedGraph_MaximumMatching(t *testing.T) {
+	// Workers 0-3 and jobs 4-7.
+	ug := getGraphFromPairs(8, [][]int{
+		{0, 4}, {0, 5}, {1, 4}, {2, 5}, {2, 6}, {2, 7}, {3, 7},
+	})
+	m, err := ug.MaximumMatching()
+	assert.NoError(t, err)
+	assert.Equal(t, 4, m.Size())
+	testMatching(t, ug)
+
+	// Only one of 0 and 1 can be matched to 2.
+	ug = getGraphFromPairs(4, [][]int{{0, 2}, {1, 2}, {1, 2}})
+	m, err = ug.MaximumMatching()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, m.Size())
+	assert.False(t, m.IsMatched(3))
+	_, ok := m.Mate(3)
+	assert.False(t, ok)
+	testMatching(t, ug)
+
+	// Not bipartite.
+	_, err = getUndirectedGraph(t).(*UndirectedGraph).MaximumMatching()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not bipartite")
+	_, err = getUndirectedGraph(t).(*UndirectedGraph).MinimumVertexCover()
+	assert.Error(t, err)
+}
+
+func TestUndirectedGraph_MaximumMatchingRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		// Edges between 0-9 and 10-19.
+		ug := NewUndirectedGraph(20).(*UndirectedGraph)
+		for j := 0; j < 25; j++ {
+			ug.AddEdge(r.Intn(10), 10+r.Intn(10))
+		}
+		testMatching(t, ug)
+	}
+}
@@ -0,0 +1,45 @@
+package undirected
+
+import "github.com/pradykaushik/data-structures/graphs"
+
+// The traversals are the ones in graphs.Traversal, which only use the Graph API. The helpers below
+// let them read the adjacency lists of the representations in this package without copying them.
+
+// adjacencyViewer is implemented by the graphs that can return the vertices adjacent to v without
+// copying them. Traversals only read the adjacency lists, and so do not need a copy.
+type adjacencyViewer interface {
+	adjacentView(v int) []int
+}
+
+// adjacentFunc returns a function giving the vertices adjacent to v, which avoids copying them if
+// the graph allows it.
+func adjacentFunc(g graphs.Graph) func(int) []int {
+	if viewer, ok := g.(adjacencyViewer); ok {
+		return viewer.adjacentView
+	}
+	return func(v int) []int {
+		adjList, _ := g.Adjacent(v)
+		return adjList
+	}
+}
+
+// newWalker returns a graphs.Walker over the graph.
+func newWalker(g graphs.Graph) *graphs.Walker {
+	return graphs.NewWalkerFunc(g, adjacentFunc(g))
+}
+
+// newTraversal returns a graphs.Traversal over the graph.
+func newTraversal(g graphs.Graph) *graphs.Traversal {
+	return graphs.NewTraversalFunc(g, adjacentFunc(g))
+}
+
+// walk runs a depth first walk from start with newWalker, so that all the representations walk
+// their adjacency lists the same way.
+// Return false if vertex does not exist.
+func walk(g graphs.Graph, start int, visitor graphs.Visitor) bool {
+	if (start < 0) || (start >= g.GetV()) {
+		return false
+	}
+	newWalker(g).Walk(start, visitor)
+	return true
+}
@@ -0,0 +1,122 @@
+package undirected
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// edgeRecorder returns a visitor that records the tree and the back edges.
+func edgeRecorder(tree, back *[][2]int) graphs.Visitor {
+	return graphs.Visitor{
+		TreeEdge: func(v, w int) graphs.WalkSignal {
+			*tree = append(*tree, [2]int{v, w})
+			return graphs.Continue
+		},
+		BackEdge: func(v, w int) graphs.WalkSignal {
+			*back = append(*back, [2]int{v, w})
+			return graphs.Continue
+		},
+		ForwardEdge: func(v, w int) graphs.WalkSignal {
+			panic("undirected graphs have no forward edges")
+		},
+		CrossEdge: func(v, w int) graphs.WalkSignal {
+			panic("undirected graphs have no cross edges")
+		},
+	}
+}
+
+func TestUndirectedGraph_Walk(t *testing.T) {
+	ug := getUndirectedGraph(t).(*UndirectedGraph)
+	var preorder, postorder []int
+	var visitor = graphs.Visitor{
+		PreVisit: func(v int) graphs.WalkSignal {
+			preorder = append(preorder, v)
+			return graphs.Continue
+		},
+		PostVisit: func(v int) graphs.WalkSignal {
+			postorder = append(postorder, v)
+			return graphs.Continue
+		},
+	}
+	assert.True(t, ug.Walk(0, visitor))
+	connected, _ := ug.ConnectedVertices(0)
+	assert.Equal(t, connected, preorder)
+	assert.ElementsMatch(t, preorder, postorder)
+	assert.Equal(t, 0, postorder[len(postorder)-1])
+
+	assert.False(t, ug.Walk(13, visitor))
+}
+
+func TestUndirectedGraph_WalkAll(t *testing.T) {
+	ug := getUndirectedGraph(t).(*UndirectedGraph)
+	var preorder []int
+	var tree, back [][2]int
+	var visitor = edgeRecorder(&tree, &back)
+	visitor.PreVisit = func(v int) graphs.WalkSignal {
+		preorder = append(preorder, v)
+		return graphs.Continue
+	}
+	graphs.WalkAll(ug, visitor)
+	assert.Equal(t, ug.Dfs(), preorder)
+	// The 13 edges are explored once each. There is a tree edge to every vertex other than
+	// the starts of the 3 components, and every other edge closes a cycle.
+	assert.Len(t, tree, 10)
+	assert.Len(t, back, 3)
+	for _, e := range back {
+		// A back edge v-w together with the tree path from w to v is a cycle.
+		path, found := ug.FindPath(e[1], e[0])
+		assert.True(t, found)
+		assert.True(t, len(path) > 2)
+	}
+}
+
+func TestUndirectedGraph_WalkSelfLoopsAndParallelEdges(t *testing.T) {
+	ug := NewUndirectedGraph(3)
+	for _, p := range [][]int{{0, 1}, {0, 1}, {1, 1}, {1, 2}} {
+		ug.AddEdge(p[0], p[1])
+	}
+	var tree, back [][2]int
+	graphs.WalkAll(ug, edgeRecorder(&tree, &back))
+	assert.Equal(t, [][2]int{{0, 1}, {1, 2}}, tree)
+	assert.ElementsMatch(t, [][2]int{{1, 1}, {1, 0}}, back)
+}
+
+func TestUndirectedGraph_WalkSignals(t *testing.T) {
+	ug := getUndirectedGraph(t).(*UndirectedGraph)
+
+	// Stopping at the first back edge finds whether there is a cycle.
+	var hasCycle = false
+	graphs.WalkAll(ug, graphs.Visitor{
+		BackEdge: func(v, w int) graphs.WalkSignal {
+			hasCycle = true
+			return graphs.Stop
+		},
+		PreVisit: func(v int) graphs.WalkSignal {
+			assert.False(t, hasCycle)
+			return graphs.Continue
+		},
+	})
+	assert.True(t, hasCycle)
+
+	// Skipping the edges of 9 leaves 10, 11 and 12 to be visited from their own starts.
+	var preorder []int
+	graphs.WalkAll(ug, graphs.Visitor{
+		PreVisit: func(v int) graphs.WalkSignal {
+			preorder = append(preorder, v)
+			if v == 9 {
+				return graphs.SkipSubtree
+			}
+			return graphs.Continue
+		},
+	})
+	assert.Equal(t, []int{0, 6, 4, 5, 3, 2, 1, 7, 8, 9, 10, 11, 12}, preorder)
+	var reached []int
+	ug.Walk(9, graphs.Visitor{
+		PreVisit: func(v int) graphs.WalkSignal {
+			reached = append(reached, v)
+			return graphs.SkipSubtree
+		},
+	})
+	assert.Equal(t, []int{9}, reached)
+}
@@ -0,0 +1,132 @@
+package undirected
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/pradykaushik/data-structures/linkedlist"
+)
+
+// WeightedUndirectedGraph is an undirected graph where every edge has a weight.
+// Every edge is stored in the adjacency lists of both its endpoints.
+type WeightedUndirectedGraph struct {
+	gph         []*linkedlist.LinkedList // adjacency list of graphs.Edge.
+	numVertices int
+	numEdges    int
+}
+
+// NewWeightedUndirectedGraph creates an edge-weighted undirected graph with the provided number of vertices.
+// Note that this graph will have no edges to begin with.
+func NewWeightedUndirectedGraph(v int) graphs.WeightedGraph {
+	g := &WeightedUndirectedGraph{
+		gph:         make([]*linkedlist.LinkedList, v),
+		numVertices: v,
+		numEdges:    0,
+	}
+
+	for i := 0; i < g.numVertices; i++ {
+		g.gph[i] = linkedlist.New()
+	}
+
+	return g
+}
+
+func (g WeightedUndirectedGraph) GetV() int {
+	return g.numVertices
+}
+
+func (g WeightedUndirectedGraph) GetE() int {
+	return g.numEdges
+}
+
+// isValid returns whether the given vertex exists in the graph.
+func (g WeightedUndirectedGraph) isValid(v int) bool {
+	return (v >= 0) && (v < len(g.gph))
+}
+
+func (g *WeightedUndirectedGraph) AddWeightedEdge(v1 int, v2 int, weight float64) bool {
+	if !g.isValid(v1) || !g.isValid(v2) {
+		return false
+	}
+
+	// As this is an undirected graph, the edge is added to the adjacency lists of both vertices.
+	e := graphs.NewEdge(v1, v2, weight)
+	g.gph[v1].AddToFront(e)
+	g.gph[v2].AddToFront(e)
+	g.numEdges++
+	return true
+}
+
+func (g WeightedUndirectedGraph) Adjacent(v int) ([]int, bool) {
+	var adjVertices []int
+	if !g.isValid(v) {
+		return adjVertices, false
+	}
+
+	for _, e := range g.gph[v].SerializeIntoArray() {
+		other, _ := e.Get().(graphs.Edge).Other(v)
+		adjVertices = append(adjVertices, other)
+	}
+	return adjVertices, true
+}
+
+func (g WeightedUndirectedGraph) AdjacentEdges(v int) ([]graphs.Edge, bool) {
+	var adjEdges []graphs.Edge
+	if !g.isValid(v) {
+		return adjEdges, false
+	}
+
+	for _, e := range g.gph[v].SerializeIntoArray() {
+		adjEdges = append(adjEdges, e.Get().(graphs.Edge))
+	}
+	return adjEdges, true
+}
+
+func (g WeightedUndirectedGraph) Degree(v int) (int, bool) {
+	if !g.isValid(v) {
+		return -1, false
+	}
+	return g.gph[v].Size(), true
+}
+
+// Edges returns all the edges in the graph.
+// As every edge is present in the adjacency lists of both its endpoints, an edge
+// is only picked up from the adjacency list of its smaller endpoint.
+// Self-loops are present twice in the same adjacency list and are picked up once.
+func (g WeightedUndirectedGraph) Edges() []graphs.Edge {
+	var edges = make([]graphs.Edge, 0, g.numEdges)
+	for v := range g.gph {
+		var selfLoops = 0
+		adjEdges, _ := g.AdjacentEdges(v)
+		for _, e := range adjEdges {
+			other, _ := e.Other(v)
+			if other > v {
+				edges = append(edges, e)
+			} else if other == v {
+				if selfLoops%2 == 0 {
+					edges = append(edges, e)
+				}
+				selfLoops++
+			}
+		}
+	}
+	return edges
+}
+
+func (g WeightedUndirectedGraph) TotalWeight() float64 {
+	var total = 0.0
+	for _, e := range g.Edges() {
+		total += e.Weight()
+	}
+	return total
+}
+
+func (g WeightedUndirectedGraph) String() string {
+	var buf = new(bytes.Buffer)
+	for v := range g.gph {
+		buf.WriteString(fmt.Sprintf("%d => ", v))
+		adjEdges, _ := g.AdjacentEdges(v)
+		buf.WriteString(fmt.Sprintf("%v\n", adjEdges))
+	}
+	return buf.String()
+}
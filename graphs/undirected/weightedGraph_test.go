@@ -0,0 +1,94 @@
+package undirected
+
+import (
+	"github.com/pradykaushik/data-structures/graphs"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// tinyEWGEdges returns the edges of tinyEWG.txt.
+// The edges are taken from https://algs4.cs.princeton.edu/43mst/.
+func tinyEWGEdges() []graphs.Edge {
+	return []graphs.Edge{
+		graphs.NewEdge(4, 5, 0.35), graphs.NewEdge(4, 7, 0.37), graphs.NewEdge(5, 7, 0.28),
+		graphs.NewEdge(0, 7, 0.16), graphs.NewEdge(1, 5, 0.32), graphs.NewEdge(0, 4, 0.38),
+		graphs.NewEdge(2, 3, 0.17), graphs.NewEdge(1, 7, 0.19), graphs.NewEdge(0, 2, 0.26),
+		graphs.NewEdge(1, 2, 0.36), graphs.NewEdge(1, 3, 0.29), graphs.NewEdge(2, 7, 0.34),
+		graphs.NewEdge(6, 2, 0.40), graphs.NewEdge(3, 6, 0.52), graphs.NewEdge(6, 0, 0.58),
+		graphs.NewEdge(6, 4, 0.93),
+	}
+}
+
+func getWeightedUndirectedGraph(t *testing.T) graphs.WeightedGraph {
+	wg := NewWeightedUndirectedGraph(8)
+	assert.NotNil(t, wg)
+	for _, e := range tinyEWGEdges() {
+		wg.AddWeightedEdge(e.From(), e.To(), e.Weight())
+	}
+	return wg
+}
+
+func TestNewWeightedUndirectedGraph(t *testing.T) {
+	wg := NewWeightedUndirectedGraph(8).(*WeightedUndirectedGraph)
+	assert.NotNil(t, wg)
+	assert.Equal(t, 8, wg.GetV())
+	assert.Equal(t, 0, wg.GetE())
+	assert.Empty(t, wg.Edges())
+}
+
+func TestWeightedUndirectedGraph_AddWeightedEdge(t *testing.T) {
+	wg := NewWeightedUndirectedGraph(8)
+	for _, e := range tinyEWGEdges() {
+		assert.True(t, wg.AddWeightedEdge(e.From(), e.To(), e.Weight()))
+	}
+	assert.Equal(t, 16, wg.GetE())
+	assert.False(t, wg.AddWeightedEdge(8, 0, 1.0))
+	assert.False(t, wg.AddWeightedEdge(-1, 0, 1.0))
+	assert.Equal(t, 16, wg.GetE())
+}
+
+func TestWeightedUndirectedGraph_Adjacent(t *testing.T) {
+	wg := getWeightedUndirectedGraph(t)
+	adjL, validVertex := wg.Adjacent(0)
+	assert.True(t, validVertex)
+	assert.Equal(t, []int{6, 2, 4, 7}, adjL)
+
+	adjEdges, validVertex := wg.AdjacentEdges(0)
+	assert.True(t, validVertex)
+	assert.Equal(t, []graphs.Edge{
+		graphs.NewEdge(6, 0, 0.58),
+		graphs.NewEdge(0, 2, 0.26),
+		graphs.NewEdge(0, 4, 0.38),
+		graphs.NewEdge(0, 7, 0.16),
+	}, adjEdges)
+
+	_, validVertex = wg.Adjacent(8)
+	assert.False(t, validVertex)
+	_, validVertex = wg.AdjacentEdges(8)
+	assert.False(t, validVertex)
+}
+
+func TestWeightedUndirectedGraph_Degree(t *testing.T) {
+	wg := getWeightedUndirectedGraph(t)
+	var expectedDegrees = []int{4, 4, 5, 3, 4, 3, 4, 5}
+	for v := 0; v < 8; v++ {
+		deg, validVertex := wg.Degree(v)
+		assert.True(t, validVertex)
+		assert.Equal(t, expectedDegrees[v], deg)
+	}
+}
+
+func TestWeightedUndirectedGraph_Edges(t *testing.T) {
+	wg := getWeightedUndirectedGraph(t)
+	assert.ElementsMatch(t, tinyEWGEdges(), wg.Edges())
+	assert.InDelta(t, 5.90, wg.TotalWeight(), 1e-9)
+
+	// Self-loops and parallel edges.
+	wg = NewWeightedUndirectedGraph(2)
+	wg.AddWeightedEdge(0, 0, 1.0)
+	wg.AddWeightedEdge(0, 1, 2.0)
+	wg.AddWeightedEdge(1, 0, 3.0)
+	assert.Equal(t, 3, wg.GetE())
+	assert.Len(t, wg.Edges(), 3)
+	assert.InDelta(t, 6.0, wg.TotalWeight(), 1e-9)
+}
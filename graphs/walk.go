@@ -0,0 +1,241 @@
+package graphs
+
+import "context"
+
+// WalkSignal is returned by the callbacks of a Visitor to control the walk.
+type WalkSignal int
+
+const (
+	// Continue the walk as usual.
+	Continue WalkSignal = iota
+	// SkipSubtree skips the vertices that would be explored next from the current vertex.
+	//   - From PreVisit, none of the edges of the vertex are explored.
+	//   - From TreeEdge, the vertex at the head of the edge is not visited through it.
+	//   - From BackEdge, ForwardEdge and CrossEdge, the remaining edges of the vertex at the tail
+	//     of the edge are not explored.
+	// PostVisit is still called for vertices whose edges are skipped.
+	SkipSubtree
+	// Stop ends the walk immediately. No further callbacks are made.
+	Stop
+)
+
+// Visitor holds the callbacks made during a depth first walk of a graph.
+// Any of the callbacks can be nil, in which case the walk continues as if it returned Continue.
+//
+// Every edge v->w explored during the walk is classified as follows.
+//   - Tree edge: w has not been visited yet, and is visited next.
+//   - Back edge: w is an ancestor of v that is still being explored. This includes self-loops.
+//   - Forward edge: w is a descendant of v that has already been explored.
+//   - Cross edge: w has already been explored, and is neither an ancestor nor a descendant of v.
+//
+// For a Digraph, the edges are explored in their direction. For any other graph, every edge is
+// explored only once, either as a tree edge or as a back edge from the descendant to the ancestor.
+// Therefore, undirected graphs have no forward or cross edges.
+type Visitor struct {
+	// PreVisit is called when v is visited for the first time.
+	PreVisit func(v int) WalkSignal
+	// PostVisit is called once all the edges of v have been explored.
+	PostVisit   func(v int) WalkSignal
+	TreeEdge    func(v, w int) WalkSignal
+	BackEdge    func(v, w int) WalkSignal
+	ForwardEdge func(v, w int) WalkSignal
+	CrossEdge   func(v, w int) WalkSignal
+}
+
+// walkFrame holds the state of a vertex whose edges are still being explored.
+type walkFrame struct {
+	v      int
+	parent int
+	adj    []int
+	next   int // index of the next vertex in adj to explore.
+	// skippedParent records whether the tree edge to the parent has been skipped once.
+	// Any other edge to the parent is a parallel edge.
+	skippedParent bool
+	// selfLoops is the number of times v has been seen in its own adjacency list.
+	// Self-loops in undirected graphs are present twice in the adjacency list.
+	selfLoops int
+}
+
+// Walker runs depth first walks over a graph, and is the traversal engine that the graph
+// algorithms are written on top of. It remembers the vertices visited, so that a vertex visited in
+// one walk is not visited again in the next. Algorithms made of several walks, such as finding the
+// connected components, use a single Walker for all of them.
+//
+// Vertices and edges are explored using an explicit stack, so that long paths do not result in
+// deep recursion.
+type Walker struct {
+	g        Graph
+	adjacent func(int) []int
+	directed bool
+	pre      []int // order in which the vertices were visited, or -1 if not yet visited.
+	onStack  []bool
+	counter  int
+}
+
+// NewWalker returns a Walker over the graph, which has not visited any vertex yet.
+// The edges are classified as directed if the graph is a Digraph.
+func NewWalker(g Graph) *Walker {
+	return NewWalkerFunc(g, func(v int) []int {
+		adjList, _ := g.Adjacent(v)
+		return adjList
+	})
+}
+
+// NewWalkerFunc is like NewWalker, but the vertices explored from v are given by adjacent(v)
+// instead of g.Adjacent(v). This allows walking a different view of the edges, such as ignoring
+// their direction, or using adjacency lists that are not copied. The walk does not modify the
+// slices returned by adjacent.
+func NewWalkerFunc(g Graph, adjacent func(v int) []int) *Walker {
+	_, directed := g.(Digraph)
+	var w = &Walker{
+		g:        g,
+		adjacent: adjacent,
+		directed: directed,
+		pre:      make([]int, g.GetV()),
+		onStack:  make([]bool, g.GetV()),
+		counter:  0,
+	}
+	for v := range w.pre {
+		w.pre[v] = -1
+	}
+	return w
+}
+
+// Visited returns whether the vertex has been visited by any of the walks so far.
+func (w *Walker) Visited(v int) bool {
+	return (v >= 0) && (v < len(w.pre)) && (w.pre[v] != -1)
+}
+
+// Walk runs a depth first walk of the graph from start, making the callbacks in visitor.
+// Only the vertices reachable from start are visited, in the same order as Dfs.
+// Return false if vertex does not exist.
+func Walk(g Graph, start int, visitor Visitor) bool {
+	if (start < 0) || (start >= g.GetV()) {
+		return false
+	}
+	NewWalker(g).Walk(start, visitor)
+	return true
+}
+
+// WalkAll runs a depth first walk from every vertex that has not been visited yet, in increasing
+// order, making the callbacks in visitor. Every vertex is visited in the same order as Dfs.
+// For a Digraph, edges into the vertices visited from an earlier start are cross edges.
+func WalkAll(g Graph, visitor Visitor) {
+	var w = NewWalker(g)
+	for v := range w.pre {
+		if w.Walk(v, visitor) == Stop {
+			return
+		}
+	}
+}
+
+// call makes a callback if it is not nil.
+func call(callback func(v, w int) WalkSignal, v, w int) WalkSignal {
+	if callback == nil {
+		return Continue
+	}
+	return callback(v, w)
+}
+
+// Walk runs a depth first walk from start, making the callbacks in visitor.
+// The vertices visited by earlier walks are skipped. For a Digraph, edges into them are cross edges.
+// Returns Stop if the walk was stopped by the visitor, and Continue otherwise.
+// Nothing is visited if start does not exist or has already been visited.
+func (w *Walker) Walk(start int, visitor Visitor) WalkSignal {
+	signal, _ := w.WalkContext(context.Background(), start, visitor)
+	return signal
+}
+
+// WalkContext is like Walk, but checks the context before visiting every vertex.
+// Returns Stop along with ctx.Err() if the context is cancelled.
+func (w *Walker) WalkContext(ctx context.Context, start int, visitor Visitor) (WalkSignal, error) {
+	if (start < 0) || (start >= len(w.pre)) || (w.pre[start] != -1) {
+		return Continue, nil
+	}
+
+	var frames = make([]*walkFrame, 0, 0)
+	// enter visits v and returns whether it was stopped.
+	var enter = func(v, parent int) bool {
+		w.pre[v] = w.counter
+		w.counter++
+		w.onStack[v] = true
+		var f = &walkFrame{v: v, parent: parent}
+		var signal = Continue
+		if visitor.PreVisit != nil {
+			signal = visitor.PreVisit(v)
+		}
+		if signal == Stop {
+			return false
+		}
+		if signal != SkipSubtree {
+			f.adj = w.adjacent(v)
+		}
+		frames = append(frames, f)
+		return true
+	}
+
+	if err := ctx.Err(); err != nil {
+		return Stop, err
+	}
+	if !enter(start, -1) {
+		return Stop, nil
+	}
+	for len(frames) > 0 {
+		f := frames[len(frames)-1]
+		if f.next == len(f.adj) {
+			frames = frames[:len(frames)-1]
+			w.onStack[f.v] = false
+			if (visitor.PostVisit != nil) && (visitor.PostVisit(f.v) == Stop) {
+				return Stop, nil
+			}
+			continue
+		}
+
+		x := f.adj[f.next]
+		f.next++
+		var signal = Continue
+		switch {
+		case w.pre[x] == -1:
+			signal = call(visitor.TreeEdge, f.v, x)
+			if signal == Continue {
+				if err := ctx.Err(); err != nil {
+					return Stop, err
+				}
+				if !enter(x, f.v) {
+					return Stop, nil
+				}
+				continue
+			}
+			// The head of the edge is not visited, so the rest of the edges of v are still explored.
+			if signal == SkipSubtree {
+				continue
+			}
+		case w.directed && w.onStack[x]:
+			signal = call(visitor.BackEdge, f.v, x)
+		case w.directed && (w.pre[x] > w.pre[f.v]):
+			signal = call(visitor.ForwardEdge, f.v, x)
+		case w.directed:
+			signal = call(visitor.CrossEdge, f.v, x)
+		case (x == f.parent) && !f.skippedParent:
+			// The tree edge to the parent, seen from the other side.
+			f.skippedParent = true
+		case x == f.v:
+			f.selfLoops++
+			if f.selfLoops%2 == 1 {
+				signal = call(visitor.BackEdge, f.v, x)
+			}
+		case w.onStack[x]:
+			signal = call(visitor.BackEdge, f.v, x)
+		default:
+			// x is a descendant that has been explored. The edge was seen from x as a back edge.
+		}
+
+		switch signal {
+		case Stop:
+			return Stop, nil
+		case SkipSubtree:
+			f.next = len(f.adj)
+		}
+	}
+	return Continue, nil
+}
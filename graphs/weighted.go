@@ -0,0 +1,41 @@
+package graphs
+
+// WeightedGraph defines an API for an edge-weighted undirected graph.
+// API taken from https://algs4.cs.princeton.edu/43mst/.
+//
+// Total number of vertices = V.
+// Total number of edges = E.
+// Vertices are numbered from 0 to V-1.
+type WeightedGraph interface {
+	GetV() int
+	GetE() int
+	// AddWeightedEdge adds an edge with the given weight to connect the two vertices.
+	// Return false if vertex does not exist.
+	AddWeightedEdge(int, int, float64) bool
+	// Adjacent returns the list of vertices adjacent to the provided one.
+	Adjacent(int) ([]int, bool)
+	// AdjacentEdges returns the list of edges incident on the provided vertex.
+	// For directed graphs, only the edges directed out of the vertex are returned.
+	AdjacentEdges(int) ([]Edge, bool)
+	// Degree returns the number of edges incident on the given vertex.
+	Degree(int) (int, bool)
+	// Edges returns all the edges in the graph.
+	// Every edge is returned exactly once.
+	Edges() []Edge
+	// TotalWeight returns the sum of the weights of all the edges in the graph.
+	TotalWeight() float64
+	// String representation of the graph.
+	String() string
+}
+
+// WeightedDigraph defines an API for an edge-weighted directed graph.
+// API taken from https://algs4.cs.princeton.edu/44sp/.
+type WeightedDigraph interface {
+	WeightedGraph
+	// InDegree returns the number of edges directed into the vertex.
+	InDegree(int) (int, bool)
+	// OutDegree returns the number of edges directed out of the vertex.
+	OutDegree(int) (int, bool)
+	// Reverse returns a copy of the digraph with all the edges reversed.
+	Reverse() WeightedDigraph
+}
@@ -0,0 +1,193 @@
+package heap
+
+import "github.com/pkg/errors"
+
+// IndexPriorityQueue is a priority queue where every key is associated with an integer index.
+// The index can be used to refer to the key once it has been inserted into the queue,
+// which allows the key to be updated or removed later on.
+type IndexPriorityQueue interface {
+	Insert(int, float64) error
+	Contains(int) bool
+	KeyOf(int) (float64, error)
+	ChangeKey(int, float64) error
+	Delete(int) error
+	// DeleteMin removes the minimum key and returns its associated index.
+	DeleteMin() (int, error)
+	MinIndex() (int, error)
+	MinKey() (float64, error)
+	IsEmpty() bool
+	Size() int
+}
+
+// IndexMinPQ is a min heap of float64 keys where every key is associated with an
+// index between 0 and capacity-1.
+//
+// pq stores the indices in heap order and qp is its inverse, i.e., qp[pq[i]] = i.
+// This allows us to locate the position of an index in the heap in constant time.
+type IndexMinPQ struct {
+	pq       []int
+	qp       []int
+	keys     []float64
+	size     int
+	capacity int
+}
+
+// NewIndexMinPQ returns an empty indexed min priority queue that can hold
+// indices between 0 and capacity-1.
+func NewIndexMinPQ(capacity int) IndexPriorityQueue {
+	h := &IndexMinPQ{
+		pq:       make([]int, capacity),
+		qp:       make([]int, capacity),
+		keys:     make([]float64, capacity),
+		size:     0,
+		capacity: capacity,
+	}
+	for i := 0; i < capacity; i++ {
+		h.qp[i] = -1
+	}
+	return h
+}
+
+func (h IndexMinPQ) withinBounds(i int) bool {
+	return (i < h.size) && (i >= 0)
+}
+
+func (h IndexMinPQ) validIndex(i int) error {
+	if (i < 0) || (i >= h.capacity) {
+		return errors.Errorf("index %d out of range", i)
+	}
+	return nil
+}
+
+func (h IndexMinPQ) IsEmpty() bool {
+	return h.size == 0
+}
+
+func (h IndexMinPQ) Size() int {
+	return h.size
+}
+
+// Contains returns whether a key is associated with the given index.
+func (h IndexMinPQ) Contains(i int) bool {
+	if h.validIndex(i) != nil {
+		return false
+	}
+	return h.qp[i] != -1
+}
+
+// Insert associates the key with the given index.
+// Returns error if the index is out of range or already present in the queue.
+func (h *IndexMinPQ) Insert(i int, key float64) error {
+	if err := h.validIndex(i); err != nil {
+		return err
+	}
+	if h.Contains(i) {
+		return errors.Errorf("index %d is already in the priority queue", i)
+	}
+	h.pq[h.size] = i
+	h.qp[i] = h.size
+	h.keys[i] = key
+	h.size++
+	h.siftUp(h.size - 1)
+	return nil
+}
+
+// KeyOf returns the key associated with the given index.
+func (h IndexMinPQ) KeyOf(i int) (float64, error) {
+	if !h.Contains(i) {
+		return 0, errors.Errorf("index %d is not in the priority queue", i)
+	}
+	return h.keys[i], nil
+}
+
+// ChangeKey updates the key associated with the given index.
+// The key can be both increased and decreased.
+func (h *IndexMinPQ) ChangeKey(i int, key float64) error {
+	if !h.Contains(i) {
+		return errors.Errorf("index %d is not in the priority queue", i)
+	}
+	h.keys[i] = key
+	// Only one of the below would move the index.
+	h.siftUp(h.qp[i])
+	h.siftDown(h.qp[i])
+	return nil
+}
+
+// Delete removes the given index and its associated key.
+func (h *IndexMinPQ) Delete(i int) error {
+	if !h.Contains(i) {
+		return errors.Errorf("index %d is not in the priority queue", i)
+	}
+	pos := h.qp[i]
+	h.size--
+	h.swap(pos, h.size)
+	h.qp[i] = -1
+	if pos < h.size {
+		h.siftUp(pos)
+		h.siftDown(pos)
+	}
+	return nil
+}
+
+// DeleteMin removes the minimum key and returns its associated index.
+func (h *IndexMinPQ) DeleteMin() (int, error) {
+	if h.IsEmpty() {
+		return -1, errors.New("priority queue is empty")
+	}
+	min := h.pq[0]
+	if err := h.Delete(min); err != nil {
+		return -1, err
+	}
+	return min, nil
+}
+
+// MinIndex returns the index associated with the minimum key.
+func (h IndexMinPQ) MinIndex() (int, error) {
+	if h.IsEmpty() {
+		return -1, errors.New("priority queue is empty")
+	}
+	return h.pq[0], nil
+}
+
+// MinKey returns the minimum key.
+func (h IndexMinPQ) MinKey() (float64, error) {
+	if h.IsEmpty() {
+		return 0, errors.New("priority queue is empty")
+	}
+	return h.keys[h.pq[0]], nil
+}
+
+func (h IndexMinPQ) less(i, j int) bool {
+	return h.keys[h.pq[i]] < h.keys[h.pq[j]]
+}
+
+func (h *IndexMinPQ) swap(i, j int) {
+	h.pq[i], h.pq[j] = h.pq[j], h.pq[i]
+	h.qp[h.pq[i]] = i
+	h.qp[h.pq[j]] = j
+}
+
+func (h *IndexMinPQ) siftUp(i int) {
+	parent := (i - 1) / 2
+	if (i > 0) && h.less(i, parent) {
+		h.swap(i, parent)
+		h.siftUp(parent)
+	}
+}
+
+func (h *IndexMinPQ) siftDown(i int) {
+	// Finding the smallest among root, left and right.
+	left := 2*i + 1
+	right := 2*i + 2
+	var smallest = i
+	if h.withinBounds(left) && h.less(left, smallest) {
+		smallest = left
+	}
+	if h.withinBounds(right) && h.less(right, smallest) {
+		smallest = right
+	}
+	if smallest != i {
+		h.swap(i, smallest)
+		h.siftDown(smallest)
+	}
+}
@@ -0,0 +1,120 @@
+package heap
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+func getIndexMinPQ(t *testing.T, keys []float64) IndexPriorityQueue {
+	pq := NewIndexMinPQ(len(keys))
+	for i, key := range keys {
+		assert.NoError(t, pq.Insert(i, key))
+	}
+	return pq
+}
+
+// testIndexMinPQ checks whether the heap property and the inverse mapping hold.
+func testIndexMinPQ(t *testing.T, pq *IndexMinPQ) {
+	for i := 0; i < pq.size; i++ {
+		assert.Equal(t, i, pq.qp[pq.pq[i]])
+		left := 2*i + 1
+		right := 2*i + 2
+		if left < pq.size {
+			assert.LessOrEqual(t, pq.keys[pq.pq[i]], pq.keys[pq.pq[left]])
+		}
+		if right < pq.size {
+			assert.LessOrEqual(t, pq.keys[pq.pq[i]], pq.keys[pq.pq[right]])
+		}
+	}
+}
+
+func TestNewIndexMinPQ(t *testing.T) {
+	pq := NewIndexMinPQ(10)
+	assert.True(t, pq.IsEmpty())
+	assert.Zero(t, pq.Size())
+	for i := 0; i < 10; i++ {
+		assert.False(t, pq.Contains(i))
+	}
+	_, err := pq.DeleteMin()
+	assert.Error(t, err)
+	_, err = pq.MinKey()
+	assert.Error(t, err)
+}
+
+func TestIndexMinPQ_Insert(t *testing.T) {
+	keys := []float64{5, 7, 10, 1, 4, 11, 13}
+	pq := getIndexMinPQ(t, keys)
+	assert.Equal(t, len(keys), pq.Size())
+	testIndexMinPQ(t, pq.(*IndexMinPQ))
+	for i, key := range keys {
+		assert.True(t, pq.Contains(i))
+		k, err := pq.KeyOf(i)
+		assert.NoError(t, err)
+		assert.Equal(t, key, k)
+	}
+
+	// Duplicate and out of range indices.
+	assert.Error(t, pq.Insert(0, 3))
+	assert.Error(t, pq.Insert(7, 3))
+	assert.Error(t, pq.Insert(-1, 3))
+
+	minIndex, err := pq.MinIndex()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, minIndex)
+	minKey, err := pq.MinKey()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, minKey)
+}
+
+func TestIndexMinPQ_DeleteMin(t *testing.T) {
+	keys := []float64{5, 7, 10, 1, 4, 11, 13}
+	pq := getIndexMinPQ(t, keys)
+	var deleted []float64
+	for !pq.IsEmpty() {
+		minKey, _ := pq.MinKey()
+		i, err := pq.DeleteMin()
+		assert.NoError(t, err)
+		assert.False(t, pq.Contains(i))
+		assert.Equal(t, keys[i], minKey)
+		deleted = append(deleted, minKey)
+		testIndexMinPQ(t, pq.(*IndexMinPQ))
+	}
+	assert.True(t, sort.Float64sAreSorted(deleted))
+	assert.Len(t, deleted, len(keys))
+}
+
+func TestIndexMinPQ_ChangeKey(t *testing.T) {
+	pq := getIndexMinPQ(t, []float64{5, 7, 10, 1, 4, 11, 13})
+	// Decreasing the key.
+	assert.NoError(t, pq.ChangeKey(6, 0))
+	testIndexMinPQ(t, pq.(*IndexMinPQ))
+	minIndex, _ := pq.MinIndex()
+	assert.Equal(t, 6, minIndex)
+
+	// Increasing the key.
+	assert.NoError(t, pq.ChangeKey(6, 20))
+	testIndexMinPQ(t, pq.(*IndexMinPQ))
+	minIndex, _ = pq.MinIndex()
+	assert.Equal(t, 3, minIndex)
+	key, _ := pq.KeyOf(6)
+	assert.Equal(t, 20.0, key)
+
+	assert.Error(t, pq.ChangeKey(7, 1))
+}
+
+func TestIndexMinPQ_Delete(t *testing.T) {
+	pq := getIndexMinPQ(t, []float64{5, 7, 10, 1, 4, 11, 13})
+	assert.NoError(t, pq.Delete(3))
+	assert.False(t, pq.Contains(3))
+	assert.Equal(t, 6, pq.Size())
+	testIndexMinPQ(t, pq.(*IndexMinPQ))
+	minIndex, _ := pq.MinIndex()
+	assert.Equal(t, 4, minIndex)
+	assert.Error(t, pq.Delete(3))
+
+	// Deleted indices can be reinserted.
+	assert.NoError(t, pq.Insert(3, 2))
+	minIndex, _ = pq.MinIndex()
+	assert.Equal(t, 3, minIndex)
+}
@@ -0,0 +1,96 @@
+package heap
+
+import (
+	"github.com/pkg/errors"
+	"github.com/pradykaushik/data-structures/util"
+)
+
+// PriorityQueue is a priority queue of values ordered using a comparison function.
+type PriorityQueue interface {
+	Insert(util.Value)
+	// DeleteMin removes and returns the minimum value.
+	DeleteMin() (util.Value, error)
+	FindMin() (util.Value, error)
+	IsEmpty() bool
+	Size() int
+}
+
+// MinPQ is a min heap of values.
+// less(a, b) should return whether value a is smaller than value b.
+type MinPQ struct {
+	data []util.Value
+	less func(util.Value, util.Value) bool
+}
+
+// NewMinPQ returns an empty min priority queue that orders values using less.
+func NewMinPQ(less func(util.Value, util.Value) bool) PriorityQueue {
+	return &MinPQ{
+		data: make([]util.Value, 0),
+		less: less,
+	}
+}
+
+func (h MinPQ) withinBounds(i int) bool {
+	return (i < len(h.data)) && (i >= 0)
+}
+
+func (h MinPQ) IsEmpty() bool {
+	return len(h.data) == 0
+}
+
+func (h MinPQ) Size() int {
+	return len(h.data)
+}
+
+func (h *MinPQ) Insert(val util.Value) {
+	// Insert val at the end and then sift up till the heap property holds.
+	h.data = append(h.data, val)
+	h.siftUp(len(h.data) - 1)
+}
+
+func (h *MinPQ) DeleteMin() (util.Value, error) {
+	if h.IsEmpty() {
+		return nil, errors.New("priority queue is empty")
+	}
+	// Swap the value at root (min) with that at the last node.
+	// Shrink the array by 1 to exclude the last value.
+	// Sift down the value at root until the heap property is restored.
+	min := h.data[0]
+	h.data[0] = h.data[len(h.data)-1]
+	h.data[len(h.data)-1] = nil // allow garbage collection.
+	h.data = h.data[:len(h.data)-1]
+	h.siftDown(0)
+	return min, nil
+}
+
+func (h MinPQ) FindMin() (util.Value, error) {
+	if h.IsEmpty() {
+		return nil, errors.New("priority queue is empty")
+	}
+	return h.data[0], nil
+}
+
+func (h *MinPQ) siftUp(i int) {
+	parent := (i - 1) / 2
+	if (i > 0) && h.less(h.data[i], h.data[parent]) {
+		h.data[parent], h.data[i] = h.data[i], h.data[parent]
+		h.siftUp(parent)
+	}
+}
+
+func (h *MinPQ) siftDown(i int) {
+	// Finding the smallest among root, left and right.
+	left := 2*i + 1
+	right := 2*i + 2
+	var smallest = i
+	if h.withinBounds(left) && h.less(h.data[left], h.data[smallest]) {
+		smallest = left
+	}
+	if h.withinBounds(right) && h.less(h.data[right], h.data[smallest]) {
+		smallest = right
+	}
+	if smallest != i {
+		h.data[smallest], h.data[i] = h.data[i], h.data[smallest]
+		h.siftDown(smallest)
+	}
+}
@@ -0,0 +1,68 @@
+package heap
+
+import (
+	"github.com/pradykaushik/data-structures/util"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type minPQVal int
+
+func (v minPQVal) Get() interface{} {
+	return int(v)
+}
+
+func lessMinPQVal(a, b util.Value) bool {
+	return a.Get().(int) < b.Get().(int)
+}
+
+func getMinPQ(data []int) PriorityQueue {
+	pq := NewMinPQ(lessMinPQVal)
+	for _, val := range data {
+		pq.Insert(minPQVal(val))
+	}
+	return pq
+}
+
+func testMinPQ(t *testing.T, data []util.Value) {
+	for i := 0; i < len(data)/2; i++ {
+		// value at index i should be at most the values at 2i+1 and 2i+2.
+		left := 2*i + 1
+		right := 2*i + 2
+		if left < len(data) {
+			assert.LessOrEqual(t, data[i].Get().(int), data[left].Get().(int))
+		}
+		if right < len(data) {
+			assert.LessOrEqual(t, data[i].Get().(int), data[right].Get().(int))
+		}
+	}
+}
+
+func TestNewMinPQ(t *testing.T) {
+	pq := NewMinPQ(lessMinPQVal)
+	assert.True(t, pq.IsEmpty())
+	_, err := pq.FindMin()
+	assert.Error(t, err)
+	_, err = pq.DeleteMin()
+	assert.Error(t, err)
+}
+
+func TestMinPQ_Insert(t *testing.T) {
+	pq := getMinPQ([]int{5, 7, 10, 1, 4, 11, 13, 4})
+	assert.Equal(t, 8, pq.Size())
+	testMinPQ(t, pq.(*MinPQ).data)
+	min, err := pq.FindMin()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, min.Get().(int))
+}
+
+func TestMinPQ_DeleteMin(t *testing.T) {
+	pq := getMinPQ([]int{5, 7, 10, 1, 4, 11, 13, 4})
+	for _, expected := range []int{1, 4, 4, 5, 7, 10, 11, 13} {
+		min, err := pq.DeleteMin()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, min.Get().(int))
+		testMinPQ(t, pq.(*MinPQ).data)
+	}
+	assert.True(t, pq.IsEmpty())
+}
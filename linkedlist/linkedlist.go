@@ -90,6 +90,28 @@ func (ll *LinkedList) Delete(val util.Value) bool {
 	return deleted
 }
 
+// DeleteFirst deletes only the first occurrence of the value.
+// Return false if the value is not present.
+func (ll *LinkedList) DeleteFirst(val util.Value) bool {
+	var prev *node
+	for cur := ll.head; cur != nil; prev, cur = cur, cur.next {
+		if cur.val != val {
+			continue
+		}
+		// prev is nil for first node.
+		if prev == nil {
+			ll.head = cur.next
+		} else {
+			prev.next = cur.next
+		}
+		// cutting the link.
+		cur.next = nil
+		ll.size--
+		return true
+	}
+	return false
+}
+
 // DeleteAtPos deletes value at the given position.
 // Return boolean indicating whether the deletion was successful.
 // The value deleted is also returned.
@@ -125,6 +147,14 @@ func (ll *LinkedList) DeleteAtPos(pos int) (util.Value, bool) {
 	return deletedVal, true
 }
 
+// Map replaces every value in the linkedlist with the result of applying f to it.
+// The order of the values is preserved.
+func (ll *LinkedList) Map(f func(util.Value) util.Value) {
+	for cur := ll.head; cur != nil; cur = cur.next {
+		cur.val = f(cur.val)
+	}
+}
+
 func (ll *LinkedList) Reverse() {
 	if ll.IsEmpty() {
 		return
@@ -1,6 +1,7 @@
 package linkedlist
 
 import (
+	"github.com/pradykaushik/data-structures/util"
 	"github.com/stretchr/testify/assert"
 	"math/rand"
 	"testing"
@@ -74,6 +75,42 @@ func TestLinkedList_Delete(t *testing.T) {
 	assert.True(t, ll.IsEmpty())
 }
 
+func TestLinkedList_DeleteFirst(t *testing.T) {
+	ll := New()
+	for _, v := range []int{1, 2, 1, 3, 1} {
+		ll.Append(LLValue(v))
+	}
+	assert.True(t, ll.DeleteFirst(LLValue(1)))
+	assert.Equal(t, 4, ll.Size())
+	assert.Equal(t, []int{2, 1, 3, 1}, getInts(ll))
+	assert.True(t, ll.DeleteFirst(LLValue(1)))
+	assert.Equal(t, []int{2, 3, 1}, getInts(ll))
+	assert.True(t, ll.DeleteFirst(LLValue(1)))
+	assert.Equal(t, []int{2, 3}, getInts(ll))
+	assert.False(t, ll.DeleteFirst(LLValue(1)))
+	assert.Equal(t, 2, ll.Size())
+
+	assert.False(t, New().DeleteFirst(LLValue(1)))
+}
+
+func TestLinkedList_Map(t *testing.T) {
+	ll := getLinkedList()
+	ll.Map(func(v util.Value) util.Value {
+		return LLValue(v.Get().(int) * 2)
+	})
+	assert.Equal(t, 10, ll.Size())
+	assert.Equal(t, []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}, getInts(ll))
+}
+
+// getInts returns the values in the linkedlist as integers.
+func getInts(ll *LinkedList) []int {
+	var values []int
+	for _, v := range ll.SerializeIntoArray() {
+		values = append(values, v.Get().(int))
+	}
+	return values
+}
+
 func TestLinkedList_DeleteAtPos(t *testing.T) {
 	ll := getLinkedList()
 	// Testing deletion of the head.
@@ -0,0 +1,60 @@
+package unionfind
+
+import "github.com/pradykaushik/data-structures/graphs"
+
+// DynamicConnectivity answers connectivity queries on an undirected graph that only grows.
+// It is fed the vertices and edges as they are added to the graph, and answers whether two vertices
+// are connected without traversing the graph.
+//
+// Removing edges or vertices can split components, which a union-find cannot undo. A new
+// DynamicConnectivity has to be created from the graph after any removal.
+type DynamicConnectivity struct {
+	uf UnionFind
+}
+
+// NewDynamicConnectivity returns a DynamicConnectivity for the current vertices and edges of
+// the graph. The graph is read once, and later changes to it are not seen unless fed using
+// AddVertex and AddEdge.
+func NewDynamicConnectivity(g graphs.Graph) *DynamicConnectivity {
+	var dc = &DynamicConnectivity{uf: NewWeightedQuickUnion(g.GetV())}
+	for v := 0; v < g.GetV(); v++ {
+		adjList, _ := g.Adjacent(v)
+		for _, w := range adjList {
+			dc.uf.Union(v, w)
+		}
+	}
+	return dc
+}
+
+// AddVertex records a new vertex with no edges, and returns it.
+// Like graphs.Graph.AddVertex, the new vertex is numbered V.
+func (dc *DynamicConnectivity) AddVertex() int {
+	return dc.uf.Add()
+}
+
+// AddEdge records an edge connecting the two vertices.
+// Return false if vertex does not exist.
+func (dc *DynamicConnectivity) AddEdge(v, w int) bool {
+	if (v < 0) || (v >= dc.uf.Size()) || (w < 0) || (w >= dc.uf.Size()) {
+		return false
+	}
+	dc.uf.Union(v, w)
+	return true
+}
+
+// Connected returns whether there is a path between the two vertices.
+func (dc *DynamicConnectivity) Connected(v, w int) bool {
+	return dc.uf.Connected(v, w)
+}
+
+// Count returns the number of connected components.
+func (dc *DynamicConnectivity) Count() int {
+	return dc.uf.Count()
+}
+
+// ComponentSize returns the number of vertices in the connected component containing the given
+// vertex.
+// Return false if vertex does not exist.
+func (dc *DynamicConnectivity) ComponentSize(v int) (int, bool) {
+	return dc.uf.ComponentSize(v)
+}
@@ -0,0 +1,63 @@
+package unionfind
+
+import (
+	"github.com/pradykaushik/data-structures/graphs/undirected"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestDynamicConnectivity(t *testing.T) {
+	ug := undirected.NewUndirectedGraph(13)
+	// tinyG.txt from https://algs4.cs.princeton.edu/41graph/, without the edges of 9.
+	for _, p := range [][]int{{0, 5}, {4, 3}, {0, 1}, {6, 4}, {5, 4}, {0, 2}, {11, 12}, {0, 6}, {7, 8}, {5, 3}} {
+		ug.AddEdge(p[0], p[1])
+	}
+	dc := NewDynamicConnectivity(ug)
+	assert.Equal(t, 5, dc.Count())
+	assert.True(t, dc.Connected(2, 3))
+	assert.False(t, dc.Connected(9, 12))
+	size, ok := dc.ComponentSize(0)
+	assert.True(t, ok)
+	assert.Equal(t, 7, size)
+
+	// Feeding the edges of 9 as they are added to the graph.
+	for _, p := range [][]int{{9, 12}, {9, 10}, {9, 11}} {
+		assert.True(t, ug.AddEdge(p[0], p[1]))
+		assert.True(t, dc.AddEdge(p[0], p[1]))
+	}
+	assert.Equal(t, 3, dc.Count())
+	assert.True(t, dc.Connected(10, 12))
+	size, _ = dc.ComponentSize(10)
+	assert.Equal(t, 4, size)
+
+	v := ug.AddVertex()
+	assert.Equal(t, v, dc.AddVertex())
+	assert.Equal(t, 4, dc.Count())
+	assert.False(t, dc.AddEdge(v, v+1))
+	assert.True(t, dc.AddEdge(v, 7))
+	assert.Equal(t, 3, dc.Count())
+	assert.True(t, dc.Connected(13, 8))
+}
+
+func TestDynamicConnectivity_AgreesWithGraph(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	const n = 50
+	ug := undirected.NewUndirectedGraph(n)
+	dc := NewDynamicConnectivity(ug)
+	for i := 0; i < 60; i++ {
+		v, w := r.Intn(n), r.Intn(n)
+		ug.AddEdge(v, w)
+		dc.AddEdge(v, w)
+
+		components := ug.FindConnectedComponents()
+		assert.Equal(t, len(components), dc.Count())
+		for _, component := range components {
+			size, _ := dc.ComponentSize(component[0])
+			assert.Equal(t, len(component), size)
+			for _, x := range component {
+				assert.True(t, dc.Connected(component[0], x))
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Package unionfind implements the union-find (disjoint set) data structure, which keeps track of
+// a partition of elements into components that can only be merged.
+package unionfind
+
+// UnionFind defines an API for a union-find.
+// API taken from https://algs4.cs.princeton.edu/15uf/.
+//
+// Elements are numbered from 0 to N-1, and every element starts out in a component of its own.
+type UnionFind interface {
+	// Union merges the components containing the two elements.
+	// Return false if either element does not exist or if they are already in the same component.
+	Union(int, int) bool
+	// Find returns the element identifying the component containing the given element.
+	// Return false if element does not exist.
+	Find(int) (int, bool)
+	// Connected returns whether the two elements are in the same component.
+	Connected(int, int) bool
+	// Count returns the number of components.
+	Count() int
+	// ComponentSize returns the number of elements in the component containing the given element.
+	ComponentSize(int) (int, bool)
+	// Add adds an element in a component of its own and returns it. The new element is numbered N.
+	Add() int
+	// Size returns the number of elements.
+	Size() int
+}
+
+// WeightedQuickUnion is a union-find where every component is a tree, with the root of the tree
+// identifying the component.
+// Linking the root of the smaller tree to the root of the larger tree keeps the trees balanced,
+// and path compression flattens them further whenever an element is looked up.
+// Both together result in nearly constant amortized time for every operation.
+type WeightedQuickUnion struct {
+	parent []int
+	size   []int // size of the tree rooted at each root.
+	count  int
+}
+
+// NewWeightedQuickUnion returns a union-find with n elements, each in a component of its own.
+func NewWeightedQuickUnion(n int) UnionFind {
+	uf := &WeightedQuickUnion{
+		parent: make([]int, n),
+		size:   make([]int, n),
+		count:  n,
+	}
+	for i := 0; i < n; i++ {
+		uf.parent[i] = i
+		uf.size[i] = 1
+	}
+	return uf
+}
+
+// isValid returns whether the given element exists.
+func (uf WeightedQuickUnion) isValid(p int) bool {
+	return (p >= 0) && (p < len(uf.parent))
+}
+
+// root returns the root of the tree containing p.
+func (uf *WeightedQuickUnion) root(p int) int {
+	var root = p
+	for root != uf.parent[root] {
+		root = uf.parent[root]
+	}
+	// Path compression. Pointing every element on the path directly to the root.
+	for p != root {
+		p, uf.parent[p] = uf.parent[p], root
+	}
+	return root
+}
+
+func (uf *WeightedQuickUnion) Union(p, q int) bool {
+	if !uf.isValid(p) || !uf.isValid(q) {
+		return false
+	}
+	rootP := uf.root(p)
+	rootQ := uf.root(q)
+	if rootP == rootQ {
+		return false
+	}
+	// The smaller tree is linked to the root of the larger tree.
+	if uf.size[rootP] < uf.size[rootQ] {
+		rootP, rootQ = rootQ, rootP
+	}
+	uf.parent[rootQ] = rootP
+	uf.size[rootP] += uf.size[rootQ]
+	uf.count--
+	return true
+}
+
+func (uf *WeightedQuickUnion) Find(p int) (int, bool) {
+	if !uf.isValid(p) {
+		return -1, false
+	}
+	return uf.root(p), true
+}
+
+func (uf *WeightedQuickUnion) Connected(p, q int) bool {
+	if !uf.isValid(p) || !uf.isValid(q) {
+		return false
+	}
+	return uf.root(p) == uf.root(q)
+}
+
+func (uf WeightedQuickUnion) Count() int {
+	return uf.count
+}
+
+func (uf *WeightedQuickUnion) ComponentSize(p int) (int, bool) {
+	if !uf.isValid(p) {
+		return -1, false
+	}
+	return uf.size[uf.root(p)], true
+}
+
+func (uf *WeightedQuickUnion) Add() int {
+	uf.parent = append(uf.parent, len(uf.parent))
+	uf.size = append(uf.size, 1)
+	uf.count++
+	return len(uf.parent) - 1
+}
+
+func (uf WeightedQuickUnion) Size() int {
+	return len(uf.parent)
+}
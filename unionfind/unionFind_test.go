@@ -0,0 +1,80 @@
+package unionfind
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWeightedQuickUnion(t *testing.T) {
+	uf := NewWeightedQuickUnion(10)
+	assert.Equal(t, 10, uf.Count())
+	assert.Equal(t, 10, uf.Size())
+	for i := 0; i < 10; i++ {
+		root, ok := uf.Find(i)
+		assert.True(t, ok)
+		assert.Equal(t, i, root)
+	}
+
+	// tinyUF.txt from https://algs4.cs.princeton.edu/15uf/.
+	assert.True(t, uf.Union(4, 3))
+	assert.True(t, uf.Union(3, 8))
+	assert.True(t, uf.Union(6, 5))
+	assert.True(t, uf.Union(9, 4))
+	assert.True(t, uf.Union(2, 1))
+	assert.True(t, uf.Connected(8, 9))
+	assert.False(t, uf.Connected(5, 4))
+	assert.True(t, uf.Union(5, 0))
+	assert.True(t, uf.Union(7, 2))
+	assert.True(t, uf.Union(6, 1))
+	assert.False(t, uf.Union(1, 0))
+	assert.False(t, uf.Union(6, 7))
+	assert.True(t, uf.Connected(0, 7))
+	assert.False(t, uf.Connected(0, 9))
+	assert.Equal(t, 2, uf.Count())
+
+	// The root of the larger tree should be the root of the merged tree.
+	root, _ := uf.Find(9)
+	assert.Equal(t, 4, root)
+	size, ok := uf.ComponentSize(9)
+	assert.True(t, ok)
+	assert.Equal(t, 4, size)
+	size, _ = uf.ComponentSize(0)
+	assert.Equal(t, 6, size)
+}
+
+func TestWeightedQuickUnion_InvalidElement(t *testing.T) {
+	uf := NewWeightedQuickUnion(3)
+	assert.False(t, uf.Union(0, 3))
+	assert.False(t, uf.Union(-1, 0))
+	assert.False(t, uf.Connected(0, 3))
+	_, ok := uf.Find(3)
+	assert.False(t, ok)
+	_, ok = uf.ComponentSize(-1)
+	assert.False(t, ok)
+	assert.Equal(t, 3, uf.Count())
+}
+
+func TestWeightedQuickUnion_Add(t *testing.T) {
+	uf := NewWeightedQuickUnion(2)
+	assert.True(t, uf.Union(0, 1))
+	assert.Equal(t, 2, uf.Add())
+	assert.Equal(t, 3, uf.Size())
+	assert.Equal(t, 2, uf.Count())
+	assert.False(t, uf.Connected(0, 2))
+	assert.True(t, uf.Union(2, 0))
+	assert.Equal(t, 1, uf.Count())
+	size, _ := uf.ComponentSize(2)
+	assert.Equal(t, 3, size)
+}
+
+func TestWeightedQuickUnion_LongChain(t *testing.T) {
+	const n = 100000
+	uf := NewWeightedQuickUnion(n)
+	for i := 0; i+1 < n; i++ {
+		uf.Union(i, i+1)
+	}
+	assert.Equal(t, 1, uf.Count())
+	assert.True(t, uf.Connected(0, n-1))
+	size, _ := uf.ComponentSize(n / 2)
+	assert.Equal(t, n, size)
+}